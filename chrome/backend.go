@@ -0,0 +1,320 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/phayes/freeport"
+
+	"streaming_hdp/devtools"
+)
+
+// DefaultHealthCheckInterval is how often a RemoteBackend checks the health of its Chrome instances.
+const DefaultHealthCheckInterval = 5 * time.Second
+
+// ErrNoRemoteChrome is returned by RemoteBackend.Launch when no remote Chrome instance is healthy.
+var ErrNoRemoteChrome = errors.New("no healthy remote Chrome instance")
+
+// Backend provides the Chrome instances handed out by an InstanceManager.
+type Backend interface {
+	// Launch returns a new Chrome instance, connected and ready to navigate to a page.
+	Launch() (*Instance, error)
+	// Close releases the resources of the backend, once the instances it launched are terminated.
+	Close() error
+}
+
+// LocalBackend starts a Chrome process on this host for each instance.
+type LocalBackend struct {
+	options   LaunchOptions      // How to start Chrome.
+	transport DebuggingTransport // How to exchange DevTools messages with Chrome.
+}
+
+// NewLocalBackend returns a Backend starting Chrome processes with opts, controlled through transport.
+func NewLocalBackend(opts LaunchOptions, transport DebuggingTransport) *LocalBackend {
+	return &LocalBackend{options: opts, transport: transport}
+}
+
+// Launch starts and connects to an instance of Chrome.
+func (b *LocalBackend) Launch() (*Instance, error) {
+	chromeInstance, err := b.newInstance()
+	if err != nil {
+		fmt.Printf("failed to create an instance of chrome\n")
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := chromeInstance.Wait(ctx); err != nil {
+		fmt.Printf("got an error starting chrome: %v\n", err)
+		chromeInstance.killInstance()
+		return nil, err
+	}
+	if err := chromeInstance.Connect(); err != nil {
+		fmt.Printf("chrome instance failed to connect to DevTools: %v\n", err)
+		return nil, err
+	}
+	return chromeInstance, nil
+}
+
+// newInstance starts an instance of Chrome using the launch options and the transport of the backend.
+func (b *LocalBackend) newInstance() (*Instance, error) {
+	if b.transport == PipeTransport {
+		return NewWithPipeAndOptions(b.options)
+	}
+	// The port may be taken by another process before Chrome listens on it.
+	chromePort, err := freeport.GetFreePort()
+	if err != nil {
+		fmt.Printf("failed to get an unused port\n")
+		return nil, err
+	}
+	return NewWithOptions(chromePort, b.options)
+}
+
+// Close implements Backend. Chrome processes are terminated along with their instances.
+func (b *LocalBackend) Close() error {
+	return nil
+}
+
+// RemoteBackend renders pages in tabs of Chrome instances running elsewhere, e.g. on a fleet of
+// rendering hosts, each tab in its own browser context. Each tab is opened in the healthy Chrome
+// instance with the fewest tabs.
+type RemoteBackend struct {
+	options   LaunchOptions             // The device the tabs render pages for.
+	keepalive devtools.KeepaliveOptions // How the connections to Chrome are probed.
+	stop      chan struct{}             // Closed to stop checking the health of the endpoints.
+
+	mu        sync.Mutex        // Protects the following fields.
+	endpoints []*remoteEndpoint // The Chrome instances, in the order they were given.
+	closed    bool              // Whether Close was called.
+}
+
+// remoteEndpoint is a Chrome instance of a RemoteBackend.
+type remoteEndpoint struct {
+	address    string     // The host:port or browser websocket URL of its DevTools.
+	connecting sync.Mutex // Serializes connecting to Chrome.
+	browser    *Instance  // The connection to Chrome, if any. Terminated along with its last tab.
+	healthy    bool       // Whether Chrome was reachable and responding when last checked.
+	err        error      // Why Chrome is unhealthy.
+}
+
+// RemoteStatus is the state of a Chrome instance of a RemoteBackend.
+type RemoteStatus struct {
+	Address string // The host:port or browser websocket URL of its DevTools.
+	Healthy bool   // Whether Chrome was reachable and responding when last checked.
+	Tabs    int    // The number of tabs open in Chrome by this backend.
+	Err     error  // Why Chrome is unhealthy, if it is.
+}
+
+// NewRemoteBackend returns a Backend opening tabs in the Chrome instances at addresses, each being
+// either the host:port of DevTools, or the URL of a browser websocket endpoint. The tabs render pages
+// for the device, locale and timezone of opts; the other launch options are up to the remote Chrome
+// instances. The health of each instance is checked every healthCheckInterval, defaulting to
+// DefaultHealthCheckInterval if zero.
+func NewRemoteBackend(addresses []string, opts LaunchOptions, healthCheckInterval time.Duration) (*RemoteBackend, error) {
+	if len(addresses) == 0 {
+		return nil, errors.New("no remote Chrome instance")
+	}
+	if healthCheckInterval <= 0 {
+		healthCheckInterval = DefaultHealthCheckInterval
+	}
+	b := &RemoteBackend{
+		options:   opts,
+		keepalive: devtools.KeepaliveOptions{Interval: healthCheckInterval},
+		stop:      make(chan struct{}),
+	}
+	for _, address := range addresses {
+		// Endpoints are presumed healthy until checked.
+		b.endpoints = append(b.endpoints, &remoteEndpoint{address: address, healthy: true})
+	}
+	go b.checkHealth(healthCheckInterval)
+	return b, nil
+}
+
+// Launch opens a tab in the healthy Chrome instance with the fewest tabs.
+func (b *RemoteBackend) Launch() (*Instance, error) {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil, ErrClosed
+	}
+	type candidate struct {
+		endpoint *remoteEndpoint
+		tabs     int
+	}
+	var candidates []candidate
+	for _, endpoint := range b.endpoints {
+		if endpoint.healthy {
+			candidates = append(candidates, candidate{endpoint, tabs(endpoint.browser)})
+		}
+	}
+	b.mu.Unlock()
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].tabs < candidates[j].tabs
+	})
+
+	for _, c := range candidates {
+		tab, err := b.newTab(c.endpoint)
+		if err == nil {
+			return tab, nil
+		}
+		fmt.Printf("failed to open a tab in chrome at %v: %v\n", c.endpoint.address, err)
+		b.mu.Lock()
+		c.endpoint.healthy = false
+		c.endpoint.err = err
+		b.mu.Unlock()
+	}
+	return nil, ErrNoRemoteChrome
+}
+
+// tabs returns the number of tabs of the browser, or zero if it is not connected.
+func tabs(browser *Instance) int {
+	if browser == nil {
+		return 0
+	}
+	return browser.Tabs()
+}
+
+// newTab opens a tab in the Chrome instance of the endpoint, connecting to it if needed.
+func (b *RemoteBackend) newTab(endpoint *remoteEndpoint) (*Instance, error) {
+	endpoint.connecting.Lock()
+	defer endpoint.connecting.Unlock()
+	browser, err := b.connect(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), methodTimeout)
+	defer cancel()
+	tab, err := browser.NewTab(ctx)
+	if err != nil && browser.Tabs() == 0 {
+		// Don't leave an unused connection behind.
+		browser.DisconnectAndTerminate()
+	}
+	return tab, err
+}
+
+// connect returns the connection to the Chrome instance of the endpoint, connecting again if the
+// previous one was terminated. endpoint.connecting must be held.
+func (b *RemoteBackend) connect(endpoint *remoteEndpoint) (*Instance, error) {
+	b.mu.Lock()
+	browser := endpoint.browser
+	b.mu.Unlock()
+	if browser != nil && browser.Err() == nil {
+		return browser, nil
+	}
+	browser, err := ConnectRemote(endpoint.address, b.options)
+	if err != nil {
+		return nil, err
+	}
+	browser.StartKeepalive(b.keepalive)
+	b.mu.Lock()
+	endpoint.browser = browser
+	endpoint.healthy = true
+	endpoint.err = nil
+	b.mu.Unlock()
+	return browser, nil
+}
+
+// checkHealth checks the health of the endpoints every interval, until the backend is closed.
+func (b *RemoteBackend) checkHealth(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-b.stop:
+			return
+		case <-ticker.C:
+		}
+		b.mu.Lock()
+		endpoints := append([]*remoteEndpoint(nil), b.endpoints...)
+		b.mu.Unlock()
+		for _, endpoint := range endpoints {
+			b.checkEndpoint(endpoint)
+		}
+	}
+}
+
+// checkEndpoint checks whether the Chrome instance of the endpoint is reachable and responding. Chrome
+// is connected to only for the check if no tab is open in it.
+func (b *RemoteBackend) checkEndpoint(endpoint *remoteEndpoint) {
+	endpoint.connecting.Lock()
+	defer endpoint.connecting.Unlock()
+	b.mu.Lock()
+	browser := endpoint.browser
+	b.mu.Unlock()
+
+	var err error
+	if browser != nil && browser.Err() == nil {
+		if !browser.Healthy() {
+			err = errors.New("chrome stopped responding")
+		}
+	} else {
+		var conn *Instance
+		conn, err = ConnectRemote(endpoint.address, b.options)
+		if err == nil {
+			conn.DisconnectAndTerminate()
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if endpoint.healthy != (err == nil) {
+		fmt.Printf("chrome at %v healthy: %v, %v\n", endpoint.address, err == nil, err)
+	}
+	endpoint.healthy = err == nil
+	endpoint.err = err
+}
+
+// Status returns the state of the Chrome instances of the backend.
+func (b *RemoteBackend) Status() []RemoteStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var status []RemoteStatus
+	for _, endpoint := range b.endpoints {
+		status = append(status, RemoteStatus{
+			Address: endpoint.address,
+			Healthy: endpoint.healthy,
+			Tabs:    tabs(endpoint.browser),
+			Err:     endpoint.err,
+		})
+	}
+	return status
+}
+
+// Close stops checking the health of the Chrome instances, and disconnects from them. Tabs still
+// open are closed.
+func (b *RemoteBackend) Close() error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	close(b.stop)
+	var browsers []*Instance
+	for _, endpoint := range b.endpoints {
+		if endpoint.browser != nil {
+			browsers = append(browsers, endpoint.browser)
+			endpoint.browser = nil
+		}
+	}
+	b.mu.Unlock()
+	terminateAll(browsers)
+	return nil
+}
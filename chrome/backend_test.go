@@ -0,0 +1,208 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"streaming_hdp/devtools"
+	"streaming_hdp/devtools/cdptest"
+)
+
+// newFakeRemoteBackend returns a RemoteBackend opening tabs in the fake Chrome instances.
+func newFakeRemoteBackend(t *testing.T, servers ...*cdptest.Server) *RemoteBackend {
+	var addresses []string
+	for _, s := range servers {
+		addresses = append(addresses, s.HostPort())
+	}
+	b, err := NewRemoteBackend(addresses, LaunchOptions{}, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("NewRemoteBackend: %v", err)
+	}
+	return b
+}
+
+// waitForHealth waits for the i-th Chrome instance of the backend to become healthy or unhealthy.
+func waitForHealth(t *testing.T, b *RemoteBackend, i int, healthy bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for b.Status()[i].Healthy != healthy {
+		if time.Now().After(deadline) {
+			t.Fatalf("Status() = %+v, want healthy: %v for chrome %v", b.Status(), healthy, i)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestRemoteBackendLeastLoaded(t *testing.T) {
+	first := cdptest.NewServer()
+	defer first.Close()
+	second := cdptest.NewServer()
+	defer second.Close()
+	b := newFakeRemoteBackend(t, first, second)
+	defer b.Close()
+
+	var tabs []*Instance
+	for i := 0; i < 3; i++ {
+		tab, err := b.Launch()
+		if err != nil {
+			t.Fatalf("Launch: %v", err)
+		}
+		tabs = append(tabs, tab)
+	}
+	if got := len(first.CallsTo("Target.createTarget")); got != 2 {
+		t.Errorf("%v tabs opened in the first chrome, want 2", got)
+	}
+	if got := len(second.CallsTo("Target.createTarget")); got != 1 {
+		t.Errorf("%v tabs opened in the second chrome, want 1", got)
+	}
+
+	// Closing the tabs of the first Chrome makes it the least loaded.
+	tabs[0].DisconnectAndTerminate()
+	tabs[2].DisconnectAndTerminate()
+	tab, err := b.Launch()
+	if err != nil {
+		t.Fatalf("Launch: %v", err)
+	}
+	if got := len(first.CallsTo("Target.createTarget")); got != 3 {
+		t.Errorf("%v tabs opened in the first chrome, want 3", got)
+	}
+	if status := b.Status(); len(status) != 2 || status[0].Tabs != 1 || status[1].Tabs != 1 {
+		t.Errorf("Status() = %+v, want one tab in each chrome", status)
+	}
+
+	// Disconnecting from Chrome kills the tabs still open.
+	b.Close()
+	for _, tab := range []*Instance{tabs[1], tab} {
+		select {
+		case <-tab.Dead():
+		case <-time.After(5 * time.Second):
+			t.Errorf("tab left open after Close")
+		}
+	}
+	if _, err := b.Launch(); err != ErrClosed {
+		t.Errorf("Launch() after Close: %v, want %v", err, ErrClosed)
+	}
+}
+
+func TestRemoteBackendHealth(t *testing.T) {
+	closed := cdptest.NewServer()
+	healthy := cdptest.NewServer()
+	defer healthy.Close()
+	b := newFakeRemoteBackend(t, closed, healthy)
+	defer b.Close()
+
+	closed.Close()
+	waitForHealth(t, b, 0, false)
+	if err := b.Status()[0].Err; err == nil {
+		t.Errorf("no error reported for the closed chrome")
+	}
+	var tabs []*Instance
+	for i := 0; i < 2; i++ {
+		tab, err := b.Launch()
+		if err != nil {
+			t.Fatalf("Launch: %v", err)
+		}
+		tabs = append(tabs, tab)
+	}
+	if got := len(healthy.CallsTo("Target.createTarget")); got != 2 {
+		t.Errorf("%v tabs opened in the healthy chrome, want 2", got)
+	}
+
+	// Chrome is probed through the connection of its tabs.
+	healthy.SetUnresponsive(true)
+	waitForHealth(t, b, 1, false)
+	if _, err := b.Launch(); err != ErrNoRemoteChrome {
+		t.Errorf("Launch() without healthy chrome: %v, want %v", err, ErrNoRemoteChrome)
+	}
+	healthy.SetUnresponsive(false)
+	waitForHealth(t, b, 1, true)
+	terminateAll(tabs)
+}
+
+// Test that Chrome instances can be given by the URL of their browser websocket endpoint.
+func TestRemoteBackendWebSocketURL(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	resp, err := http.Get("http://" + s.HostPort() + "/json/version")
+	if err != nil {
+		t.Fatalf("failed to get the version of chrome: %v", err)
+	}
+	var version devtools.BrowserVersion
+	err = json.NewDecoder(resp.Body).Decode(&version)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatalf("failed to decode the version of chrome: %v", err)
+	}
+
+	b, err := NewRemoteBackend([]string{version.WebSocketDebuggerURL}, LaunchOptions{}, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("NewRemoteBackend: %v", err)
+	}
+	defer b.Close()
+	tab, err := b.Launch()
+	if err != nil {
+		t.Fatalf("Launch: %v", err)
+	}
+	defer tab.DisconnectAndTerminate()
+	if _, err := tab.NavigateToPage("http://example.com/"); err != nil {
+		t.Fatalf("NavigateToPage: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	call, err := s.WaitForCall(ctx, "Page.navigate")
+	if err != nil {
+		t.Fatalf("Page.navigate was not invoked: %v", err)
+	}
+	if call.SessionID == "" {
+		t.Errorf("Page.navigate invoked outside of a tab")
+	}
+}
+
+// Test that an InstanceManager hands out the tabs of a RemoteBackend, and closes it.
+func TestInstanceManagerWithRemoteBackend(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	b := newFakeRemoteBackend(t, s)
+	im := newInstanceManager(b)
+	im.SetPool(PoolOptions{MinIdle: 1, MaxIdle: 1, AcquireTimeout: 5 * time.Second})
+	go im.maintainPool()
+
+	id, err := im.AcquireInstance("http://example.com/")
+	if err != nil {
+		t.Fatalf("AcquireInstance: %v", err)
+	}
+	chromeInstance, err := im.GetInstance(id)
+	if err != nil {
+		t.Fatalf("GetInstance(%v): %v", id, err)
+	}
+	if chromeInstance.parent == nil {
+		t.Errorf("the instance handed out is not a tab of the remote chrome")
+	}
+	if _, err := chromeInstance.NavigateToPage("http://example.com/"); err != nil {
+		t.Fatalf("NavigateToPage: %v", err)
+	}
+	if err := im.ReleaseInstance(id); err != nil {
+		t.Errorf("ReleaseInstance(%v): %v", id, err)
+	}
+
+	closeInstanceManager(t, im)
+	if _, err := b.Launch(); err != ErrClosed {
+		t.Errorf("Launch() after closing the manager: %v, want %v", err, ErrClosed)
+	}
+}
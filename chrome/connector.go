@@ -20,39 +20,71 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
-	"log"
+	"net/http"
 	"os"
 	"os/exec"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"streaming_hdp/devtools"
+	"streaming_hdp/devtools/cdp"
 	"streaming_hdp/dom"
 )
 
 const (
-	pageStableThreshold  = time.Duration(5) * time.Second / time.Millisecond
-	userAgentString      = "Mozilla/5.0 (Linux; Android 4.4.4; XT1034 Build/KXB21.14-L1.61) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/59.0.3071.125 Mobile Safari/537.36 PTST/170721.190705"
-	viewPortWidth        = 360
-	viewPortHeight       = 640
-	viewPortPixelDensity = 2
+	userAgentString = "Mozilla/5.0 (Linux; Android 4.4.4; XT1034 Build/KXB21.14-L1.61) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/59.0.3071.125 Mobile Safari/537.36 PTST/170721.190705"
+	methodTimeout   = time.Duration(10) * time.Second
 )
 
-var (
-	instanceTimeout = time.Duration(25) * time.Second
-)
+// ErrNotConnected is returned when an instance is used before it is connected to Chrome.
+var ErrNotConnected = errors.New("not connected to a Chrome instance")
+
+// connectRetryInterval is how long Connect waits before trying again to connect to DevTools.
+var connectRetryInterval = 2 * time.Second
 
 // Instance represents an instance of Chrome.
 type Instance struct {
 	port              int                  // The port for connecting to DevTools.
 	Command           *exec.Cmd            // The Chrome instance command.
 	devtoolsConn      *devtools.Connection // The connection to Chrome DevTools.
+	target            devtools.Target      // The tab of Chrome, controlled through devtoolsConn.
+	pipeIn            *os.File             // The pipe Chrome reads DevTools methods from, when using --remote-debugging-pipe.
+	pipeOut           *os.File             // The pipe Chrome writes DevTools messages to, when using --remote-debugging-pipe.
 	userDir           string               // Chrome's user directory. Should be delete upon termination.
-	timeoutTimer      *time.Timer          // The timer for detecting timeout. The timer will be reset every time the instance receives a new event.
 	mu                sync.Mutex           // Mutex to guard race condition on c.devtoolsConn
 	pageLoadCompletes chan bool            // Channel to signal when the page load completes.
 	ready             chan bool            // Channel to signal when the connection to DevTools has been established.
+	recording         *os.File             // The file the DevTools messages are recorded to, if any.
+	options           LaunchOptions        // How Chrome was started, and the device it renders pages for.
+	browserContextID  string               // The browser context of the tab, empty for the default one.
+	navigations       int                  // The number of pages navigated to, across resets.
+	parent            *Instance            // For tabs created by NewTab, the Instance owning the Chrome process.
+	tabs              int                  // The number of tabs created by NewTab and not terminated yet.
+	exited            chan struct{}        // Closed once the Chrome process started by the instance has exited.
+	stopWatch         chan struct{}        // Closed to stop watching the tab, e.g. when it is replaced.
+	deadMu            sync.Mutex           // Protects dead and deadErr.
+	dead              chan struct{}        // Closed once the instance can no longer be used. Created lazily.
+	deadErr           error                // Why the instance died. Only set once dead is closed.
+	cgroup            string               // The cgroup Chrome runs in, if any. Removed upon termination.
+	lastActivity      time.Time            // When the instance was last active, see Touch.
+	startedAt         time.Time            // When Chrome was started, or the first page began.
+	remote            string               // The address of Chrome, for instances connected to with ConnectRemote.
+	page              pageState            // The state of the page being rendered, replaced by Reset. Protected by mu.
+}
+
+// pageState is the state of an Instance for the page it renders, from Begin until the next Reset.
+type pageState struct {
+	ctx           context.Context    // The context of the page the instance is handed out for, see Begin.
+	endPage       func(error)        // Ends the lifecycle of the page, canceling ctx with the reason.
+	stability     StabilityCondition // How the next page is deemed stable, if set with SetStability.
+	stable        chan struct{}      // Closed once the page is stable. Created lazily, see Stable.
+	stableOnce    *sync.Once         // Closes stable once.
+	stopBudget    chan struct{}      // Closed to stop enforcing the budget of the page, e.g. when navigating to another.
+	stopIntercept chan struct{}      // Closed to stop intercepting the requests of the page, e.g. when navigating to another.
+	interceptions []Interception     // The requests of the page blocked, rewritten or mocked so far.
+	clientHeaders http.Header        // The headers of the client of the page forwarded to Chrome, see SetClientHeaders.
 }
 
 // New returns a new Chrome instance and also starts a headless
@@ -61,107 +93,245 @@ type Instance struct {
 //	- port: the port for connecting to DevTools.
 //	- useFullChrome: whether to start Chrome with in headless mode or not.
 func New(port int, useFullChrome bool) (*Instance, error) {
-	dir, err := ioutil.TempDir("/tmp/", "chrome_data")
+	return NewWithOptions(port, LaunchOptions{UseFullChrome: useFullChrome})
+}
+
+// NewWithOptions returns a new Chrome instance started with opts, with
+// the specified port for connecting to DevTools.
+func NewWithOptions(port int, opts LaunchOptions) (*Instance, error) {
+	instance := &Instance{
+		port:    port,
+		ready:   make(chan bool, 1),
+		options: opts,
+	}
+	if err := instance.start("--remote-debugging-port="+strconv.Itoa(port), nil); err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
+
+// NewWithPipe returns a new Chrome instance and also starts a headless
+// Chrome in the background, which exchanges DevTools messages with the proxy
+// over pipes. Unlike New, no port is opened: only the proxy can control Chrome.
+// Args:
+//	- useFullChrome: whether to start Chrome with in headless mode or not.
+func NewWithPipe(useFullChrome bool) (*Instance, error) {
+	return NewWithPipeAndOptions(LaunchOptions{UseFullChrome: useFullChrome})
+}
+
+// NewWithPipeAndOptions returns a new Chrome instance started with opts,
+// which exchanges DevTools messages with the proxy over pipes.
+func NewWithPipeAndOptions(opts LaunchOptions) (*Instance, error) {
+	// Chrome reads methods from its file descriptor 3, and writes messages to its file descriptor 4.
+	chromeIn, pipeIn, err := os.Pipe()
 	if err != nil {
-		fmt.Printf("failed to create a temporary user data directory: %v\n", err)
 		return nil, err
 	}
-	chrome := "google-chrome"
-	args := []string{
-		"--remote-debugging-port=" + strconv.Itoa(port),
-		"--user-data-dir=" + dir,
-		"about:blank",
+	pipeOut, chromeOut, err := os.Pipe()
+	if err != nil {
+		chromeIn.Close()
+		pipeIn.Close()
+		return nil, err
 	}
-	if !useFullChrome {
-		args = append(args, "--headless")
+	instance := &Instance{
+		pipeIn:  pipeIn,
+		pipeOut: pipeOut,
+		ready:   make(chan bool, 1),
+		options: opts,
 	}
-	chromeCmd := exec.Command(chrome, args...)
-	err = chromeCmd.Start()
+	err = instance.start("--remote-debugging-pipe", []*os.File{chromeIn, chromeOut})
+	// Chrome has its own copy of its ends of the pipes.
+	chromeIn.Close()
+	chromeOut.Close()
 	if err != nil {
+		pipeIn.Close()
+		pipeOut.Close()
 		return nil, err
 	}
-	return &Instance{
-		port:    port,
-		Command: chromeCmd,
-		userDir: dir,
-		ready:   make(chan bool, 1),
-	}, nil
+	return instance, nil
 }
 
-// Started returns whether Chrome has started.
-func (c *Instance) started() (bool, error) {
-	pid := c.Command.Process.Pid
-	out, err := exec.Command("kill", "-s", "0", strconv.Itoa(pid)).CombinedOutput()
+// start starts Chrome with the DevTools flag and the launch options of the instance,
+// passing it extraFiles from file descriptor 3 on.
+func (c *Instance) start(devtoolsFlag string, extraFiles []*os.File) error {
+	root := c.options.UserDataDirRoot
+	if root == "" {
+		root = DefaultUserDataDirRoot
+	}
+	dir, err := ioutil.TempDir(root, "chrome_data")
 	if err != nil {
-		fmt.Printf("error finding process: %v\n", err)
-		return false, err
+		fmt.Printf("failed to create a temporary user data directory: %v\n", err)
+		return err
+	}
+	chrome := c.options.Binary
+	if chrome == "" {
+		chrome = DefaultBinary
+	}
+	args := []string{
+		devtoolsFlag,
+		"--user-data-dir=" + dir,
+	}
+	args = append(args, c.options.args()...)
+	args = append(args, "about:blank")
+	chromeCmd := exec.Command(chrome, args...)
+	chromeCmd.ExtraFiles = extraFiles
+	limits := c.options.Limits
+	cgroup := ""
+	if limits.CgroupParent != "" {
+		cgroup, err = createCgroup(limits.CgroupParent, limits)
+		if err != nil {
+			fmt.Printf("failed to create a cgroup: %v\n", err)
+			os.RemoveAll(dir)
+			return err
+		}
+		f, err := placeInCgroup(chromeCmd, cgroup)
+		if err != nil {
+			removeCgroup(cgroup)
+			os.RemoveAll(dir)
+			return err
+		}
+		defer f.Close()
+	}
+	err = chromeCmd.Start()
+	if err != nil {
+		if cgroup != "" {
+			removeCgroup(cgroup)
+		}
+		os.RemoveAll(dir)
+		return err
+	}
+	c.Command = chromeCmd
+	c.userDir = dir
+	c.cgroup = cgroup
+	if err := setRlimits(chromeCmd.Process.Pid, limits); err != nil {
+		fmt.Printf("failed to set the rlimits of Chrome: %v\n", err)
+		c.killInstance()
+		return err
 	}
+	c.exited = make(chan struct{})
+	c.startedAt = time.Now()
+	go c.waitProcess()
+	return nil
+}
 
-	if string(out) == "" {
-		return true, nil // pid exist
+// Attach returns an Instance controlling Chrome through an established connection, e.g. a
+// devtools.Connection replaying a recording. The Instance has no Chrome process to terminate.
+func Attach(conn *devtools.Connection) *Instance {
+	c := &Instance{
+		devtoolsConn:      conn,
+		target:            conn,
+		pageLoadCompletes: make(chan bool),
+		ready:             make(chan bool),
 	}
-	return false, nil
+	close(c.ready)
+	return c
 }
 
-// Wait waits until Chrome has started.
-func (c *Instance) Wait(ctx context.Context) error {
-	ok := false
-	for !ok {
-		select {
-		case <-ctx.Done():
-			return errors.New("timeout waiting for Chrome to start")
-		default:
-			ok, err := c.started()
-			if ok || err != nil {
-				return err
-			}
-			time.Sleep(5 * time.Second)
-		}
+// ConnectRemote returns an Instance controlling the Chrome running at address, e.g. on another host,
+// through a browser-level connection: address is either the host:port of its DevTools, or the URL of
+// its browser websocket endpoint. The instance has no tab of its own: pages are rendered in the tabs
+// created by NewTab. Terminating the instance disconnects from Chrome, without stopping it.
+func ConnectRemote(address string, opts LaunchOptions) (*Instance, error) {
+	var conn *devtools.Connection
+	var err error
+	if strings.HasPrefix(address, "ws://") || strings.HasPrefix(address, "wss://") {
+		conn, err = devtools.NewBrowserConnectionToURL(address)
+	} else {
+		conn, err = devtools.NewBrowserConnection(address)
 	}
-	return nil
+	if err != nil {
+		return nil, err
+	}
+	c := &Instance{
+		devtoolsConn:      conn,
+		pageLoadCompletes: make(chan bool),
+		ready:             make(chan bool),
+		options:           opts,
+		remote:            address,
+		startedAt:         time.Now(),
+	}
+	close(c.ready)
+	c.mu.Lock()
+	c.supervise()
+	c.mu.Unlock()
+	return c, nil
 }
 
-// InitializeTimeout starts the timer for resetting this chrome instance.
-func (c *Instance) InitializeTimeout() {
-	if c.timeoutTimer != nil {
-		log.Fatalf("InitializeTimeout was already called\n")
+// Started returns whether Chrome has started, and is still running.
+func (c *Instance) started() (bool, error) {
+	select {
+	case <-c.exited:
+		return false, c.Err()
+	default:
+		return true, nil
 	}
-	timeoutTimer := time.AfterFunc(instanceTimeout, func() {
-		c.DisconnectAndTerminate()
-	})
-	c.timeoutTimer = timeoutTimer
 }
 
-// ResetTimeout resets the timeout. Returns true if the timeout hasn't expired, false otherwise.
-func (c *Instance) ResetTimeout() bool {
-	beforeTimedout := c.timeoutTimer.Stop()
-	if beforeTimedout {
-		c.timeoutTimer.Reset(instanceTimeout)
+// Wait waits until Chrome has started.
+func (c *Instance) Wait(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return errors.New("timeout waiting for Chrome to start")
+	default:
+	}
+	ok, err := c.started()
+	if !ok && err == nil {
+		err = errors.New("Chrome exited")
 	}
-	return beforeTimedout
+	return err
 }
 
 // Connect connects to a tab on the Chrome instance.
 func (c *Instance) Connect() error {
+	if c.pipeIn != nil {
+		return c.connectPipe()
+	}
 	tryLimit := 5
 	tryCounter := 0
+	var connection *devtools.Connection
 	var err error
 	for tryCounter < tryLimit {
-		// TODO(vaspol): This assumes that Chrome is always running locally.
-		connection, err := devtools.NewConnection("localhost:" + strconv.Itoa(c.port))
+		// Chrome on other hosts is connected to with ConnectRemote.
+		connection, err = devtools.NewConnection("localhost:" + strconv.Itoa(c.port))
 		if err == nil {
+			c.mu.Lock()
 			c.devtoolsConn = connection
+			c.target = connection
+			c.pageLoadCompletes = make(chan bool)
+			c.supervise()
+			c.mu.Unlock()
 			break
 		}
 		tryCounter++
-		time.Sleep(2 * time.Second) // Sleep until the next try.
+		time.Sleep(connectRetryInterval) // Sleep until the next try.
 	}
 	if tryCounter >= tryLimit {
 		fmt.Printf("failed to connect to devtools on port: %v\n", c.port)
 		c.killInstance()
 		return err
 	}
+	close(c.ready)
+	return nil
+}
+
+// connectPipe connects to a tab on the Chrome instance through the DevTools pipes.
+func (c *Instance) connectPipe() error {
+	connection := devtools.NewConnectionWithTransport(devtools.NewPipeTransport(c.pipeOut, c.pipeIn))
+	ctx, cancel := context.WithTimeout(context.Background(), methodTimeout)
+	defer cancel()
+	session, err := connection.AttachToPage(ctx)
+	if err != nil {
+		fmt.Printf("failed to attach to a tab through the devtools pipe: %v\n", err)
+		connection.Close()
+		c.killInstance()
+		return err
+	}
+	c.mu.Lock()
+	c.devtoolsConn = connection
+	c.target = session
 	c.pageLoadCompletes = make(chan bool)
+	c.supervise()
+	c.mu.Unlock()
 	close(c.ready)
 	return nil
 }
@@ -173,9 +343,23 @@ func (c *Instance) DisconnectAndTerminate() error {
 	if c.devtoolsConn == nil {
 		return nil
 	}
+	c.markDead(ErrTerminated)
+	c.endLifecycle(c.Err())
+	c.stopSupervising()
+	c.stopEnforcingBudget()
+	c.stopIntercepting()
 	close(c.pageLoadCompletes) // Send a signal that the page load has complete.
+	if c.parent != nil {
+		c.closeTab()
+		return nil
+	}
 	c.devtoolsConn.Close()
 	c.devtoolsConn = nil
+	c.target = nil
+	if c.recording != nil {
+		c.recording.Close()
+		c.recording = nil
+	}
 	if err := c.killInstance(); err != nil {
 		fmt.Printf("failed to kill Chrome instance: %v\n", err)
 		return err
@@ -183,17 +367,144 @@ func (c *Instance) DisconnectAndTerminate() error {
 	return nil
 }
 
+// NewTab returns an Instance controlling a new tab of this Chrome instance, in its own browser context:
+// the tab shares the Chrome process, but no cookie, storage or cache, with the other tabs. Terminating
+// the tab disposes of its browser context, and terminates this Chrome instance if it was its last tab.
+// Only instances controlled through a browser-level connection, i.e. started with NewWithPipe, have tabs.
+func (c *Instance) NewTab(ctx context.Context) (*Instance, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.devtoolsConn == nil {
+		return nil, ErrNotConnected
+	}
+	if _, ok := c.target.(*devtools.Session); (!ok && c.remote == "") || c.parent != nil {
+		return nil, errors.New("only instances controlled through a browser-level connection have tabs")
+	}
+	browserContextID, err := c.devtoolsConn.CreateBrowserContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session, err := c.devtoolsConn.NewTab(ctx, browserContextID)
+	if err != nil {
+		c.devtoolsConn.DisposeBrowserContext(ctx, browserContextID)
+		return nil, err
+	}
+	c.tabs++
+	tab := &Instance{
+		devtoolsConn:      c.devtoolsConn,
+		target:            session,
+		browserContextID:  browserContextID,
+		parent:            c,
+		options:           c.options,
+		pageLoadCompletes: make(chan bool),
+		ready:             make(chan bool),
+	}
+	close(tab.ready)
+	tab.mu.Lock()
+	tab.supervise()
+	tab.mu.Unlock()
+	return tab, nil
+}
+
+// Tabs returns the number of tabs created by NewTab that are not terminated yet.
+func (c *Instance) Tabs() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.tabs
+}
+
+// closeTab disposes of the browser context of the tab, and terminates the Chrome instance of the
+// tab if it was its last one. c.mu must be held.
+func (c *Instance) closeTab() {
+	ctx, cancel := context.WithTimeout(context.Background(), methodTimeout)
+	defer cancel()
+	if err := c.devtoolsConn.DisposeBrowserContext(ctx, c.browserContextID); err != nil {
+		fmt.Printf("failed to dispose of the browser context of the tab: %v\n", err)
+	}
+	c.devtoolsConn = nil
+	c.target = nil
+
+	c.parent.mu.Lock()
+	c.parent.tabs--
+	last := c.parent.tabs == 0
+	c.parent.mu.Unlock()
+	if last {
+		c.parent.DisconnectAndTerminate()
+	}
+}
+
 // killInstance kills the Chrome process by sending the Kill signal to the process.
+// Instances without a process, e.g. connected to a fake Chrome in tests, have nothing to kill.
 func (c *Instance) killInstance() error {
-	if err := c.Command.Process.Kill(); err != nil {
+	if c.Command == nil || c.Command.Process == nil {
+		return nil
+	}
+	// Chrome may have exited on its own, e.g. after crashing.
+	if err := c.Command.Process.Kill(); err != nil && err != os.ErrProcessDone {
 		fmt.Printf("failed to kill Chrome instance: %v\n", err)
 		return err
 	}
-	os.RemoveAll(c.userDir)
-	if _, err := c.Command.Process.Wait(); err != nil {
+	if c.options.UserDataDir == RemoveUserDataDir {
+		os.RemoveAll(c.userDir)
+	}
+	if c.exited != nil {
+		<-c.exited
+	} else if _, err := c.Command.Process.Wait(); err != nil {
 		fmt.Printf("failed on waiting Chrome instance: %v\n", err)
 		return err
 	}
+	if c.cgroup != "" {
+		if err := removeCgroup(c.cgroup); err != nil {
+			fmt.Printf("failed to remove the cgroup of Chrome instance: %v\n", err)
+		}
+	}
+	return nil
+}
+
+// terminated returns whether the instance was disconnected from Chrome and terminated.
+func (c *Instance) terminated() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.devtoolsConn == nil
+}
+
+// StartKeepalive probes Chrome periodically, to detect when it stops responding. See devtools.Connection.StartKeepalive.
+func (c *Instance) StartKeepalive(opts devtools.KeepaliveOptions) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.devtoolsConn == nil {
+		return
+	}
+	c.devtoolsConn.StartKeepalive(opts)
+}
+
+// Healthy returns whether this Chrome instance is connected and responds to keepalive probes.
+func (c *Instance) Healthy() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.devtoolsConn != nil && c.devtoolsConn.Healthy()
+}
+
+// RecordTo records the DevTools messages exchanged with this Chrome instance to the file at path,
+// until the instance is terminated. The recording can be replayed with a devtools.ReplayTransport.
+func (c *Instance) RecordTo(path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.devtoolsConn == nil {
+		return ErrNotConnected
+	}
+	if c.recording != nil {
+		return errors.New("the Chrome instance is already being recorded")
+	}
+	if c.parent != nil {
+		return errors.New("tabs sharing a Chrome process cannot be recorded on their own")
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	c.recording = f
+	c.devtoolsConn.Record(f)
 	return nil
 }
 
@@ -202,104 +513,293 @@ func (c *Instance) killInstance() error {
 //	- domains: contains the name of the domain to be enabled.
 func (c *Instance) EnableDomains(domains ...string) {
 	// Ensure that we already have connected to Chrome DevTools.
-	if c.devtoolsConn == nil {
-		fmt.Printf("%p trying to enable domains but not connected to Devtools", c)
+	dc, err := c.tab()
+	if err != nil {
+		fmt.Printf("%p trying to enable domains but not connected to Devtools: %v\n", c, err)
+		return
 	}
-	dc := c.devtoolsConn
 	for _, domain := range domains {
 		dc.InvokeMethod(domain+".enable", devtools.Params{})
 	}
 }
 
+// DiscardEvents stops keeping the events of this Chrome instance for NextEvent, for handlers only reading
+// them through Subscribe. Reset gives the instance a new tab, which keeps its events again.
+func (c *Instance) DiscardEvents() {
+	if dc, err := c.tab(); err == nil {
+		dc.DiscardEvents()
+	}
+}
+
 // NextEvent returns the next event received by this Chrome instance.
-// This also resets the timer set for detecting the instance timeout.
+// This also postpones the idle timeout of the instance, see Touch.
 func (c *Instance) NextEvent() (devtools.EventMessage, error) {
-	c.ResetTimeout()
-	return c.devtoolsConn.NextEvent()
+	c.Touch()
+	dc, err := c.tab()
+	if err != nil {
+		return devtools.EventMessage{}, err
+	}
+	return dc.NextEvent()
 }
 
-// NavigateToPage navigates to the specified page.
+// NextEventContext is like NextEvent, but returns ctx.Err() if ctx is done while waiting for an event.
+func (c *Instance) NextEventContext(ctx context.Context) (devtools.EventMessage, error) {
+	c.Touch()
+	dc, err := c.tab()
+	if err != nil {
+		return devtools.EventMessage{}, err
+	}
+	return dc.NextEventContext(ctx)
+}
+
+// Subscribe returns a channel receiving the events of this Chrome instance whose method matches methodPattern,
+// e.g. "Page.loadEventFired" or "DOM.*". Call the returned function to stop receiving events.
+// The channel is closed right away if the instance is not connected.
+func (c *Instance) Subscribe(methodPattern string) (<-chan devtools.EventMessage, func()) {
+	dc, err := c.tab()
+	if err != nil {
+		events := make(chan devtools.EventMessage)
+		close(events)
+		return events, func() {}
+	}
+	return dc.Subscribe(methodPattern)
+}
+
+// NavigateToPage navigates to the specified page. The returned Navigation gets the response to the
+// request of the page. Fails with ErrNotConnected before Connect, or if Chrome could not navigate.
 // Args:
 //	- page:	    the URL of the page to navigate to.
-func (c *Instance) NavigateToPage(page string) error {
+func (c *Instance) NavigateToPage(page string) (*Navigation, error) {
 	fmt.Printf("Navigating to: %v\n", page)
 	// Ensure that we already have connected to Chrome DevTools.
-	if c.devtoolsConn == nil {
-		log.Fatalf("%v navigating to %v, but is not connected to Chrome on port %v\n", c, page, c.port)
+	dc, err := c.tab()
+	if err != nil {
+		return nil, err
 	}
 
-	// Setup handler for when the page load stablizes.
-	// Use emulation domain to monitor when the page stablizes.
-	dc := c.devtoolsConn
-	dc.InvokeMethod("Network.setUserAgentOverride", devtools.Params{
-		"userAgent": userAgentString,
-	})
+	c.emulate(dc)
+	if c.options.Budget.enabled() {
+		c.startBudget()
+	}
+	if mainHeaders := c.mainRequestHeaders(); c.options.Interceptor != nil || mainHeaders != nil {
+		c.startInterception(page, mainHeaders)
+	} else {
+		c.disableInterception()
+	}
+	referrer := c.forwardClientHeaders(page)
+	// Watch for the page to stabilize.
+	c.watchStability()
 
-	dc.InvokeMethod("Emulation.setDeviceMetricsOverride", devtools.Params{
-		"width":             viewPortWidth,
-		"height":            viewPortHeight,
-		"deviceScaleFactor": viewPortPixelDensity,
-		"mobile":            true,
-	})
+	// Navigate to the target site.
+	params := devtools.Params{"url": page}
+	if referrer != "" {
+		params["referrer"] = referrer
+	}
+	dc.InvokeMethod("Network.enable", devtools.Params{})
+	c.mu.Lock()
+	c.navigations++
+	c.mu.Unlock()
+	return c.navigate(dc, page, params)
+}
+
+// Navigations returns the number of pages this Chrome instance navigated to, across resets.
+func (c *Instance) Navigations() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.navigations
+}
 
-	result := dc.InvokeMethodAndGetReturn("Emulation.setVirtualTimePolicy",
-		devtools.Params{
-			"policy": "pauseIfNetworkFetchesPending",
-			"budget": int(pageStableThreshold),
-		})
-	if result.Type == devtools.ResultError {
-		fmt.Printf("method invocation error: %v\n", result.Params)
+// MemoryUsage returns the resident memory of the Chrome processes of this instance, in bytes, or the
+// memory of its cgroup if it runs in one. Tabs created by NewTab report the memory of the Chrome
+// instance they share.
+func (c *Instance) MemoryUsage() (int64, error) {
+	if c.parent != nil {
+		return c.parent.MemoryUsage()
+	}
+	if c.cgroup != "" {
+		return cgroupMemory(c.cgroup)
 	}
+	if c.Command == nil || c.Command.Process == nil {
+		return 0, errors.New("no Chrome process")
+	}
+	return processTreeRSS(c.Command.Process.Pid)
+}
 
-	// Navigate to the target site.
-	dc.InvokeMethod("Page.navigate", devtools.Params{
-		"url": page})
+// resettable returns whether the instance is connected through a browser-level connection, and can be Reset.
+func (c *Instance) resettable() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.target.(*devtools.Session)
+	return ok
+}
+
+// Reset prepares this Chrome instance to render another page: it ends the lifecycle of the previous page, signals the end
+// of the page load, and replaces the tab with a blank one in a fresh browser context, so that no
+// cookie, storage or cache is shared with the previous page. Only instances controlled through a
+// browser-level connection, i.e. started with NewWithPipe, can be reset.
+func (c *Instance) Reset(ctx context.Context) error {
+	c.mu.Lock()
+	conn := c.devtoolsConn
+	if conn == nil {
+		c.mu.Unlock()
+		return ErrNotConnected
+	}
+	if _, ok := c.target.(*devtools.Session); !ok {
+		c.mu.Unlock()
+		return errors.New("only instances controlled through a browser-level connection can be reset")
+	}
+	c.endLifecycle(errPageDone)
+	c.stopEnforcingBudget()
+	c.stopIntercepting()
+	c.page = pageState{}
+	if c.recording != nil {
+		conn.Record(nil)
+		c.recording.Close()
+		c.recording = nil
+	}
+	c.mu.Unlock()
+
+	// Chrome may take a while to create the tab, during which the instance must stay usable, e.g. to
+	// be terminated.
+	browserContextID, err := conn.CreateBrowserContext(ctx)
+	if err != nil {
+		return err
+	}
+	tab, err := conn.NewTab(ctx, browserContextID)
+	if err != nil {
+		conn.DisposeBrowserContext(ctx, browserContextID)
+		return err
+	}
+
+	c.mu.Lock()
+	if c.devtoolsConn != conn {
+		// The instance was terminated meanwhile.
+		c.mu.Unlock()
+		conn.DisposeBrowserContext(ctx, browserContextID)
+		if err := c.Err(); err != nil {
+			return err
+		}
+		return ErrNotConnected
+	}
+	// Closing the previous tab must not kill the instance.
+	c.stopSupervising()
+	previous, previousContextID := c.target, c.browserContextID
+	c.target = tab
+	c.browserContextID = browserContextID
+	c.supervise()
+	close(c.pageLoadCompletes) // Send a signal that the page load has complete.
+	c.pageLoadCompletes = make(chan bool)
+	c.mu.Unlock()
+
+	// Disposing of the browser context deletes its data along with its tabs.
+	if previousContextID != "" {
+		err = conn.DisposeBrowserContext(ctx, previousContextID)
+	} else if session, ok := previous.(*devtools.Session); ok {
+		err = session.Close()
+	}
+	if err != nil {
+		fmt.Printf("failed to close the previous tab: %v\n", err)
+	}
 	return nil
 }
 
+// emulate makes Chrome render pages for the device, locale and timezone of the launch options, or the
+// User-Agent and language of the client.
+func (c *Instance) emulate(dc devtools.Target) {
+	device := c.options.device()
+	userAgentOverride := devtools.Params{
+		"userAgent": device.UserAgent,
+	}
+	if c.options.Locale != "" {
+		userAgentOverride["acceptLanguage"] = c.options.Locale
+	}
+	c.mu.Lock()
+	if userAgent := c.page.clientHeaders.Get("User-Agent"); userAgent != "" {
+		userAgentOverride["userAgent"] = userAgent
+	}
+	if language := c.page.clientHeaders.Get("Accept-Language"); language != "" {
+		userAgentOverride["acceptLanguage"] = language
+	}
+	c.mu.Unlock()
+	dc.InvokeMethod("Network.setUserAgentOverride", userAgentOverride)
+
+	dc.InvokeMethod("Emulation.setDeviceMetricsOverride", devtools.Params{
+		"width":             device.Width,
+		"height":            device.Height,
+		"deviceScaleFactor": device.DeviceScaleFactor,
+		"mobile":            device.Mobile,
+	})
+	if device.Touch {
+		dc.InvokeMethod("Emulation.setTouchEmulationEnabled", devtools.Params{"enabled": true})
+	}
+	if c.options.Locale != "" {
+		dc.InvokeMethod("Emulation.setLocaleOverride", devtools.Params{"locale": c.options.Locale})
+	}
+	if c.options.Timezone != "" {
+		dc.InvokeMethod("Emulation.setTimezoneOverride", devtools.Params{"timezoneId": c.options.Timezone})
+	}
+}
+
 // GetDOMInstance returns an instance to the root node of the DOM tree.
 func (c *Instance) GetDOMInstance() (dom.Node, error) {
-	dc := c.devtoolsConn
-	if dc == nil {
-		log.Fatalf("%v getting DOM, but is not connected to Chrome on port %v\n", c, c.port)
+	if _, err := c.tab(); err != nil {
+		return nil, err
 	}
-	resp := dc.InvokeMethodAndGetReturn("DOM.getDocument", devtools.Params{"depth": -1})
-	if resp.Type == devtools.ResultError {
-		fmt.Printf("unable to get DOM: %v\n", resp.Params)
+	resp, err := c.call("DOM.getDocument", devtools.Params{"depth": -1})
+	if err != nil {
+		fmt.Printf("unable to get DOM: %v\n", err)
 		return nil, errors.New("unable to get the root document from DevTools")
 	}
 	// Check if the response is valid.
-	if _, ok := resp.Params["root"]; !ok {
+	root, ok := resp["root"].(map[string]interface{})
+	if !ok {
 		return nil, errors.New("malformed response. Missing \"root\" attribute")
 	}
-	return dom.Node(resp.Params["root"].(map[string]interface{})), nil
+	return dom.Node(root), nil
 }
 
 // GetDOM retrieves the DOM from Chrome.
 func (c *Instance) GetDOM() (string, error) {
-	dc := c.devtoolsConn
-	if dc == nil {
-		log.Fatalf("Getting DOM, but is not connected to Chrome on port %v\n", c.port)
+	dc, err := c.tab()
+	if err != nil {
+		return "", err
 	}
 	root, err := c.GetDOMInstance()
 	if err != nil {
 		return "", err
 	}
-	output := dc.InvokeMethodAndGetReturn("DOM.getOuterHTML", devtools.Params{"nodeId": int(root["nodeId"].(float64))})
-	if output.Type == devtools.ResultError {
+	nodeID, ok := devtools.Params(root).Int(dom.NodeID)
+	if !ok {
+		return "", errors.New("malformed response. Missing \"nodeId\" attribute")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), methodTimeout)
+	defer cancel()
+	rootID := cdp.DOMNodeID(nodeID)
+	output, err := cdp.DOM{Caller: dc}.GetOuterHTML(ctx, &cdp.DOMGetOuterHTMLParams{NodeID: &rootID})
+	if err != nil {
+		fmt.Printf("unable to get outerHTML: %v\n", err)
 		return "", errors.New("unable to get the DOM from DevTools")
 	}
-	if _, ok := output.Params["outerHTML"]; !ok {
-		return "", errors.New("outerHTML attribute missing from the response")
+	return output.OuterHTML, nil
+}
+
+// call invokes the method on Chrome and waits at most methodTimeout for its return value.
+func (c *Instance) call(methodName string, params devtools.Params) (devtools.Params, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), methodTimeout)
+	defer cancel()
+	dc, err := c.tab()
+	if err != nil {
+		return nil, err
 	}
-	return output.Params["outerHTML"].(string), nil
+	return dc.Call(ctx, methodName, params)
 }
 
 // RequestChildNodes tells Chrome to monitor the given node for subsequent children changes to the node.
 func (c *Instance) RequestChildNodes(nodeID float64) {
-	dc := c.devtoolsConn
-	if dc == nil {
-		log.Fatalf("%p requesting dom, but is not connected to Chrome on port %v\n", c, c.port)
+	dc, err := c.tab()
+	if err != nil {
+		fmt.Printf("%p requesting dom, but is not connected to Chrome on port %v: %v\n", c, c.port, err)
+		return
 	}
 	dc.InvokeMethod("DOM.requestChildNodes", devtools.Params{
 		"nodeId": nodeID,
@@ -315,8 +815,20 @@ func (c *Instance) WaitUntilPageLoadCompletes() {
 // WaitUntilChromeReady will block until we are connected to DevTools.
 func (c *Instance) WaitUntilChromeReady() error {
 	<-c.ready
-	if c.devtoolsConn == nil {
-		return errors.New("not connected to a Chrome instance")
+	_, err := c.tab()
+	return err
+}
+
+// tab returns the tab of Chrome controlled by this instance, or why the instance is not connected to it:
+// ErrNotConnected, or the error that terminated the instance.
+func (c *Instance) tab() (devtools.Target, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.target == nil {
+		if err := c.Err(); err != nil {
+			return nil, err
+		}
+		return nil, ErrNotConnected
 	}
-	return nil
+	return c.target, nil
 }
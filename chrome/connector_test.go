@@ -16,12 +16,15 @@ package chrome
 
 import (
 	"context"
-	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
+
+	"streaming_hdp/devtools"
+	"streaming_hdp/devtools/cdptest"
 )
 
 // Note: None of the tests work on Forge. However, it works locally.
@@ -29,6 +32,7 @@ import (
 
 // Test just launching and terminating Chrome.
 func TestLaunchAndTerminateChrome(t *testing.T) {
+	cdptest.RequireChrome(t)
 	t.Logf("Started TestLaunchAndTerminateChrome\n")
 	chromeInstance, err := New(9222, true)
 	if err != nil {
@@ -46,7 +50,7 @@ func TestLaunchAndTerminateChrome(t *testing.T) {
 		t.Errorf("Failed to connect Chrome.")
 	}
 
-	chromeInstance.InitializeTimeout()
+	chromeInstance.Begin(context.Background(), DefaultLifecycleOptions())
 
 	chromeInstance.DisconnectAndTerminate()
 	if _, err = os.Stat(chromeInstance.userDir); !os.IsNotExist(err) {
@@ -56,6 +60,7 @@ func TestLaunchAndTerminateChrome(t *testing.T) {
 
 // Test timeout implementation when Chrome is idle for more than 5 seconds.
 func TestTimeoutTriggered(t *testing.T) {
+	cdptest.RequireChrome(t)
 	chromeInstance, err := New(9223, true)
 	if err != nil {
 		t.Fatalf("Could not launch Chrome: %v", err)
@@ -72,10 +77,7 @@ func TestTimeoutTriggered(t *testing.T) {
 		t.Errorf("Failed to connect Chrome.")
 	}
 
-	originalTimeout := instanceTimeout
-	instanceTimeout = time.Millisecond
-	chromeInstance.InitializeTimeout()
-	instanceTimeout = originalTimeout
+	chromeInstance.Begin(context.Background(), LifecycleOptions{IdleTimeout: time.Millisecond})
 
 	// This will implicitly test whether the timeout works. If it doesn't,
 	// this will hang forever and the test will eventually timeout.
@@ -85,6 +87,7 @@ func TestTimeoutTriggered(t *testing.T) {
 // Test navigate to page by just seeing that it finishes at one point and
 // make sure that the events are being received properly.
 func TestNavigateToPageAndDomainEvents(t *testing.T) {
+	cdptest.RequireChrome(t)
 	tests := []struct {
 		label           string
 		expectedRetCode int
@@ -120,7 +123,7 @@ func TestNavigateToPageAndDomainEvents(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Failed to connect Chrome.")
 			}
-			chromeInstance.InitializeTimeout()
+			chromeInstance.Begin(context.Background(), DefaultLifecycleOptions())
 
 			// Enable Network domain and setup callbacks.
 			chromeInstance.EnableDomains("Network")
@@ -130,7 +133,7 @@ func TestNavigateToPageAndDomainEvents(t *testing.T) {
 				pageStabilized := false
 				for {
 					event, err := chromeInstance.NextEvent()
-					if err == io.EOF {
+					if err != nil {
 						// no more events to process.
 						break
 					}
@@ -164,3 +167,287 @@ func TestNavigateToPageAndDomainEvents(t *testing.T) {
 		})
 	}
 }
+
+// newFakeInstance returns an Instance connected to the fake Chrome, without a Chrome process.
+func newFakeInstance(t *testing.T, s *cdptest.Server) *Instance {
+	chromeInstance := &Instance{
+		port:  s.Listener.Addr().(*net.TCPAddr).Port,
+		ready: make(chan bool),
+	}
+	if err := chromeInstance.Connect(); err != nil {
+		t.Fatalf("Failed to connect to the fake Chrome: %v", err)
+	}
+	return chromeInstance
+}
+
+// connectToFakeChrome returns an Instance connected to the fake Chrome, with its timeout started.
+func connectToFakeChrome(t *testing.T, s *cdptest.Server) *Instance {
+	chromeInstance := newFakeInstance(t, s)
+	chromeInstance.Begin(context.Background(), DefaultLifecycleOptions())
+	return chromeInstance
+}
+
+// Test navigating to a page and retrieving its DOM against a fake Chrome.
+func TestNavigateToPageAndGetDOMHermetic(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.HandleResult("DOM.getDocument", devtools.Params{
+		"root": devtools.Params{"nodeId": 1, "nodeType": 9, "nodeName": "#document"},
+	})
+	s.Handle("DOM.getOuterHTML", func(call cdptest.Call) (devtools.Params, error) {
+		if nodeID, _ := call.Params.Int("nodeId"); nodeID != 1 {
+			return nil, &devtools.ProtocolError{Code: -32000, Message: "Could not find node with given id"}
+		}
+		return devtools.Params{"outerHTML": "<html><body>bar</body></html>"}, nil
+	})
+	chromeInstance := connectToFakeChrome(t, s)
+	defer chromeInstance.DisconnectAndTerminate()
+	if err := chromeInstance.WaitUntilChromeReady(); err != nil {
+		t.Fatalf("WaitUntilChromeReady: %v", err)
+	}
+
+	stabilized, cancelSubscription := chromeInstance.Subscribe("Emulation.virtualTimeBudgetExpired")
+	defer cancelSubscription()
+	if _, err := chromeInstance.NavigateToPage("http://example.com/"); err != nil {
+		t.Fatalf("NavigateToPage: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	call, err := s.WaitForCall(ctx, "Page.navigate")
+	if err != nil {
+		t.Fatalf("Page.navigate was not invoked: %v", err)
+	}
+	if url, _ := call.Params.String("url"); url != "http://example.com/" {
+		t.Errorf("Page.navigate url = %q, want %q", url, "http://example.com/")
+	}
+	uaCalls := s.CallsTo("Network.setUserAgentOverride")
+	if len(uaCalls) != 1 {
+		t.Fatalf("Network.setUserAgentOverride invoked %v times, want 1", len(uaCalls))
+	}
+	if ua, _ := uaCalls[0].Params.String("userAgent"); ua != userAgentString {
+		t.Errorf("userAgent = %q, want %q", ua, userAgentString)
+	}
+
+	s.Emit(devtools.EventMessage{Method: "Emulation.virtualTimeBudgetExpired", Params: devtools.Params{}})
+	select {
+	case <-stabilized:
+	case <-ctx.Done():
+		t.Fatalf("Emulation.virtualTimeBudgetExpired was not received")
+	}
+
+	html, err := chromeInstance.GetDOM()
+	if err != nil {
+		t.Fatalf("GetDOM: %v", err)
+	}
+	if want := "<html><body>bar</body></html>"; html != want {
+		t.Errorf("GetDOM() = %q, want %q", html, want)
+	}
+}
+
+// Test that the methods of a terminated instance fail instead of exiting or panicking.
+func TestTerminatedInstanceHermetic(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	chromeInstance := connectToFakeChrome(t, s)
+	if err := chromeInstance.DisconnectAndTerminate(); err != nil {
+		t.Fatalf("DisconnectAndTerminate: %v", err)
+	}
+
+	if _, err := chromeInstance.GetDOM(); err == nil {
+		t.Errorf("GetDOM() succeeded after DisconnectAndTerminate")
+	}
+	if _, err := chromeInstance.GetDOMInstance(); err == nil {
+		t.Errorf("GetDOMInstance() succeeded after DisconnectAndTerminate")
+	}
+	if _, err := chromeInstance.NextEvent(); err == nil {
+		t.Errorf("NextEvent() succeeded after DisconnectAndTerminate")
+	}
+	if _, err := chromeInstance.NavigateToPage("http://example.com/"); err == nil {
+		t.Errorf("NavigateToPage() succeeded after DisconnectAndTerminate")
+	}
+	events, cancel := chromeInstance.Subscribe("Page.*")
+	defer cancel()
+	if _, ok := <-events; ok {
+		t.Errorf("Subscribe() received an event after DisconnectAndTerminate")
+	}
+	chromeInstance.RequestChildNodes(1)
+	chromeInstance.EnableDomains("Page")
+	chromeInstance.DiscardEvents()
+}
+
+// Test connecting to a tab through the pipes of --remote-debugging-pipe, against a fake Chrome.
+func TestConnectFailsHermetic(t *testing.T) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	// Nothing listens on the port anymore.
+	listener.Close()
+	defer func(interval time.Duration) { connectRetryInterval = interval }(connectRetryInterval)
+	connectRetryInterval = time.Millisecond
+
+	chromeInstance := &Instance{
+		port:  listener.Addr().(*net.TCPAddr).Port,
+		ready: make(chan bool),
+	}
+	if err := chromeInstance.Connect(); err == nil {
+		t.Fatalf("Connect() to a port nothing listens on succeeded")
+	}
+	select {
+	case <-chromeInstance.ready:
+		t.Errorf("the instance is ready although it failed to connect")
+	default:
+	}
+}
+
+func TestConnectThroughPipeHermetic(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	chromeIn, pipeIn, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe: %v", err)
+	}
+	pipeOut, chromeOut, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe: %v", err)
+	}
+	go s.ServePipe(chromeIn, chromeOut)
+
+	chromeInstance := &Instance{
+		pipeIn:  pipeIn,
+		pipeOut: pipeOut,
+		ready:   make(chan bool, 1),
+	}
+	if err := chromeInstance.Connect(); err != nil {
+		t.Fatalf("Failed to connect to the fake Chrome: %v", err)
+	}
+	chromeInstance.Begin(context.Background(), DefaultLifecycleOptions())
+	defer chromeInstance.DisconnectAndTerminate()
+
+	if _, err := chromeInstance.NavigateToPage("http://example.com/"); err != nil {
+		t.Fatalf("NavigateToPage: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	call, err := s.WaitForCall(ctx, "Page.navigate")
+	if err != nil {
+		t.Fatalf("Page.navigate was not invoked: %v", err)
+	}
+	if want := cdptest.SessionID(cdptest.PageTargetID); call.SessionID != want {
+		t.Errorf("Page.navigate was invoked on session %q, want %q", call.SessionID, want)
+	}
+}
+
+func TestNewTabHermetic(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	browser, err := fakePipeLaunch(s)()
+	if err != nil {
+		t.Fatalf("Failed to connect to the fake Chrome: %v", err)
+	}
+	defer browser.DisconnectAndTerminate()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	first, err := browser.NewTab(ctx)
+	if err != nil {
+		t.Fatalf("NewTab: %v", err)
+	}
+	second, err := browser.NewTab(ctx)
+	if err != nil {
+		t.Fatalf("NewTab: %v", err)
+	}
+	if first.browserContextID == "" || first.browserContextID == second.browserContextID {
+		t.Errorf("tabs in browser contexts %q and %q, want distinct ones", first.browserContextID, second.browserContextID)
+	}
+	if _, err := first.NewTab(ctx); err == nil {
+		t.Errorf("NewTab on a tab succeeded")
+	}
+
+	first.NavigateToPage("http://example.com/")
+	call, err := s.WaitForCall(ctx, "Page.navigate")
+	if err != nil {
+		t.Fatalf("Page.navigate was not invoked: %v", err)
+	}
+	if want := first.target.(*devtools.Session).ID; call.SessionID != want {
+		t.Errorf("Page.navigate was invoked on session %q, want %q", call.SessionID, want)
+	}
+
+	first.DisconnectAndTerminate()
+	disposed := s.CallsTo("Target.disposeBrowserContext")
+	if len(disposed) != 1 {
+		t.Fatalf("Target.disposeBrowserContext invoked %v times, want 1", len(disposed))
+	}
+	if id, _ := disposed[0].Params.String("browserContextId"); id != first.browserContextID {
+		t.Errorf("disposed of browser context %q, want %q", id, first.browserContextID)
+	}
+	if browser.Tabs() != 1 || browser.terminated() {
+		t.Errorf("Chrome has %v tabs, terminated: %v, want 1 tab left", browser.Tabs(), browser.terminated())
+	}
+	second.DisconnectAndTerminate()
+	if !browser.terminated() {
+		t.Errorf("Chrome was not terminated along with its last tab")
+	}
+}
+
+func TestResetReplacesPageState(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	chromeInstance, err := fakePipeLaunch(s)()
+	if err != nil {
+		t.Fatalf("Failed to connect to the fake Chrome: %v", err)
+	}
+	defer chromeInstance.DisconnectAndTerminate()
+	chromeInstance.Begin(context.Background(), DefaultLifecycleOptions())
+	chromeInstance.SetStability(DOMQuiet(time.Second))
+	chromeInstance.SetClientHeaders(http.Header{"Cookie": {"session=1"}})
+	chromeInstance.Stable()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := chromeInstance.Reset(ctx); err != nil {
+		t.Fatalf("Reset: %v", err)
+	}
+	chromeInstance.mu.Lock()
+	defer chromeInstance.mu.Unlock()
+	if page := chromeInstance.page; page.ctx != nil || page.stability != nil || page.stable != nil || page.clientHeaders != nil {
+		t.Errorf("the state of the previous page was kept after Reset: %+v", page)
+	}
+}
+
+func TestResetDoesNotBlockInstance(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	chromeInstance, err := fakePipeLaunch(s)()
+	if err != nil {
+		t.Fatalf("Failed to connect to the fake Chrome: %v", err)
+	}
+	defer chromeInstance.DisconnectAndTerminate()
+	release := make(chan struct{})
+	s.Handle("Target.createTarget", func(cdptest.Call) (devtools.Params, error) {
+		<-release
+		return devtools.Params{"targetId": "target-reset"}, nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	reset := make(chan error, 1)
+	go func() { reset <- chromeInstance.Reset(ctx) }()
+	if _, err := s.WaitForCall(ctx, "Target.createTarget"); err != nil {
+		t.Fatalf("Target.createTarget was not invoked: %v", err)
+	}
+	healthy := make(chan bool, 1)
+	go func() { healthy <- chromeInstance.Healthy() }()
+	select {
+	case <-healthy:
+	case <-time.After(time.Second):
+		t.Errorf("the instance is blocked while Reset creates the tab")
+	}
+	close(release)
+	if err := <-reset; err != nil {
+		t.Fatalf("Reset: %v", err)
+	}
+	if _, err := chromeInstance.tab(); err != nil {
+		t.Errorf("the instance has no tab after Reset: %v", err)
+	}
+}
@@ -0,0 +1,321 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// The resource types of Fetch.requestPaused matched by the type options of the filters.
+var filterTypes = map[string][]string{
+	"script":         {"Script"},
+	"image":          {"Image"},
+	"stylesheet":     {"Stylesheet"},
+	"object":         {"Other"},
+	"xmlhttprequest": {"XHR", "Fetch"},
+	"subdocument":    {"Document"},
+	"media":          {"Media"},
+	"font":           {"Font"},
+	"ping":           {"Ping"},
+	"websocket":      {"WebSocket"},
+	"other":          {"Other", "Manifest", "TextTrack", "EventSource"},
+}
+
+// FilterList is a list of filters in the EasyList format, matching the requests to block, e.g. the ones
+// of ads and trackers. See https://help.eyeo.com/adblockplus/how-to-write-filters. Only the filters of
+// requests are supported: element hiding filters, and the filters with options other than the
+// resource types, third-party, domain, match-case and important, are ignored.
+type FilterList struct {
+	Name       string // The name of the list, reported along with the filters matching requests.
+	Filters    int    // The number of filters supported.
+	blocking   filterIndex
+	exceptions filterIndex
+}
+
+// filterIndex looks up the filters possibly matching a request.
+type filterIndex struct {
+	byHost  map[string][]*filter // The filters anchored to a domain, e.g. "||ads.example.com^", by domain.
+	generic []*filter            // The other filters.
+}
+
+// filter is a filter of a FilterList.
+type filter struct {
+	text       string
+	pattern    *regexp.Regexp
+	literal    string          // A substring of the URLs matched, lowercase unless matchCase, checked before pattern.
+	matchCase  bool            // Whether the pattern is case sensitive.
+	thirdParty int             // 1 to only match third-party requests, -1 to only match first-party ones.
+	types      map[string]bool // If set, the only resource types matched.
+	notTypes   map[string]bool // The resource types not matched.
+	domains    []string        // If set, only the requests of pages on these domains are matched.
+	notDomains []string        // The requests of pages on these domains are not matched.
+}
+
+// LoadFilterList reads the filter list at path, e.g. a copy of EasyList.
+func LoadFilterList(path string) (*FilterList, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	list, err := ParseFilterList(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the filter list %v: %v", path, err)
+	}
+	list.Name = filepath.Base(path)
+	return list, nil
+}
+
+// ParseFilterList parses a filter list in the EasyList format.
+func ParseFilterList(r io.Reader) (*FilterList, error) {
+	list := &FilterList{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "!") || strings.HasPrefix(line, "[") {
+			continue
+		}
+		if strings.Contains(line, "##") || strings.Contains(line, "#@#") || strings.Contains(line, "#?#") || strings.Contains(line, "#$#") {
+			// Element hiding filters.
+			continue
+		}
+		index := &list.blocking
+		if strings.HasPrefix(line, "@@") {
+			index = &list.exceptions
+			line = line[2:]
+		}
+		f, host, ok := parseFilter(line)
+		if !ok {
+			continue
+		}
+		index.add(f, host)
+		list.Filters++
+	}
+	return list, scanner.Err()
+}
+
+// parseFilter parses the filter text, without its exception prefix. Returns the domain the filter is
+// anchored to, if any, and whether the filter is supported.
+func parseFilter(text string) (*filter, string, bool) {
+	f := &filter{text: text}
+	pattern := text
+	if i := strings.LastIndex(text, "$"); i >= 0 && !strings.Contains(text[i:], "/") {
+		pattern = text[:i]
+		if !f.parseOptions(text[i+1:]) {
+			return nil, "", false
+		}
+	}
+	if pattern == "" {
+		pattern = "*"
+	}
+
+	var expr string
+	host := ""
+	if len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		expr = pattern[1 : len(pattern)-1]
+	} else {
+		expr, host = filterRegexp(pattern)
+		f.literal = filterLiteral(pattern)
+		if !f.matchCase {
+			f.literal = strings.ToLower(f.literal)
+		}
+	}
+	if !f.matchCase {
+		expr = "(?i)" + expr
+	}
+	var err error
+	if f.pattern, err = regexp.Compile(expr); err != nil {
+		return nil, "", false
+	}
+	return f, host, true
+}
+
+// parseOptions parses the comma-separated options of the filter. Returns false if one is not supported.
+func (f *filter) parseOptions(options string) bool {
+	for _, option := range strings.Split(options, ",") {
+		negated := strings.HasPrefix(option, "~")
+		name := strings.TrimPrefix(option, "~")
+		switch {
+		case name == "third-party":
+			f.thirdParty = 1
+			if negated {
+				f.thirdParty = -1
+			}
+		case name == "first-party":
+			f.thirdParty = -1
+			if negated {
+				f.thirdParty = 1
+			}
+		case name == "match-case":
+			f.matchCase = true
+		case name == "important":
+			// Exceptions still apply.
+		case strings.HasPrefix(option, "domain="):
+			for _, domain := range strings.Split(strings.TrimPrefix(option, "domain="), "|") {
+				if strings.HasPrefix(domain, "~") {
+					f.notDomains = append(f.notDomains, strings.ToLower(domain[1:]))
+				} else if domain != "" {
+					f.domains = append(f.domains, strings.ToLower(domain))
+				}
+			}
+		case filterTypes[name] != nil:
+			types := &f.types
+			if negated {
+				types = &f.notTypes
+			}
+			if *types == nil {
+				*types = make(map[string]bool)
+			}
+			for _, resourceType := range filterTypes[name] {
+				(*types)[resourceType] = true
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// filterRegexp returns the regular expression of the pattern of a filter, and the domain it is
+// anchored to with "||", if any.
+func filterRegexp(pattern string) (string, string) {
+	var expr strings.Builder
+	host := ""
+	switch {
+	case strings.HasPrefix(pattern, "||"):
+		pattern = pattern[2:]
+		expr.WriteString(`^[a-z][a-z0-9.+-]*:(?://)?(?:[^/?#]*\.)?`)
+		// Only filters matching whole domains can be looked up by domain.
+		end := strings.IndexAny(pattern, "^/*|:?$")
+		if end < 0 {
+			end = len(pattern)
+		}
+		if end > 0 && (end == len(pattern) || pattern[end] == '^' || pattern[end] == '/') {
+			host = strings.ToLower(pattern[:end])
+		}
+	case strings.HasPrefix(pattern, "|"):
+		pattern = pattern[1:]
+		expr.WriteString("^")
+	}
+	anchored := strings.HasSuffix(pattern, "|")
+	pattern = strings.TrimSuffix(pattern, "|")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			expr.WriteString(".*")
+		case '^':
+			// A separator: anything but a letter, a digit, or one of _-.%, or the end of the URL.
+			expr.WriteString(`(?:[^\w.%-]|$)`)
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	if anchored {
+		expr.WriteString("$")
+	}
+	return expr.String(), host
+}
+
+// filterLiteral returns the longest part of the pattern of a filter matched literally.
+func filterLiteral(pattern string) string {
+	longest := ""
+	for _, part := range strings.FieldsFunc(pattern, func(r rune) bool { return r == '*' || r == '^' || r == '|' }) {
+		if len(part) > len(longest) {
+			longest = part
+		}
+	}
+	return longest
+}
+
+// add adds a filter to the index, anchored to host if set.
+func (index *filterIndex) add(f *filter, host string) {
+	if host == "" {
+		index.generic = append(index.generic, f)
+		return
+	}
+	if index.byHost == nil {
+		index.byHost = make(map[string][]*filter)
+	}
+	index.byHost[host] = append(index.byHost[host], f)
+}
+
+// match returns the first filter of the index matching the request.
+func (index *filterIndex) match(req interceptedRequest) *filter {
+	for host := req.host; host != ""; {
+		for _, f := range index.byHost[host] {
+			if f.matches(req) {
+				return f
+			}
+		}
+		i := strings.Index(host, ".")
+		if i < 0 {
+			break
+		}
+		host = host[i+1:]
+	}
+	for _, f := range index.generic {
+		if f.matches(req) {
+			return f
+		}
+	}
+	return nil
+}
+
+// matches returns whether the filter matches the request.
+func (f *filter) matches(req interceptedRequest) bool {
+	if (f.thirdParty > 0 && !req.thirdParty) || (f.thirdParty < 0 && req.thirdParty) {
+		return false
+	}
+	if (f.types != nil && !f.types[req.resourceType]) || f.notTypes[req.resourceType] {
+		return false
+	}
+	if (f.domains != nil && !onDomains(req.pageHost, f.domains)) || onDomains(req.pageHost, f.notDomains) {
+		return false
+	}
+	url := req.lowerURL
+	if f.matchCase {
+		url = req.url
+	}
+	if !strings.Contains(url, f.literal) {
+		return false
+	}
+	return f.pattern.MatchString(req.url)
+}
+
+// onDomains returns whether host is one of the domains, or one of their subdomains.
+func onDomains(host string, domains []string) bool {
+	for _, domain := range domains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// match returns the filter blocking the request, if any is not overridden by an exception.
+func (list *FilterList) match(req interceptedRequest) *filter {
+	f := list.blocking.match(req)
+	if f == nil || list.exceptions.match(req) != nil {
+		return nil
+	}
+	return f
+}
@@ -0,0 +1,80 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"strings"
+	"testing"
+)
+
+const testFilterList = `[Adblock Plus 2.0]
+! Title: test list
+||ads.example.com^
+||tracker.net^$third-party
+/banner/*/img^
+@@||ads.example.com/allowed/
+|http://plain.example.org/exact.js|
+.mp4$media,domain=video.example.com|~safe.video.example.com
+example.com##.ad
+||cdn.example.com/Script.js$script,match-case
+||popup.example.com^$popup
+/\/ad[0-9]+\.png$/
+`
+
+func TestFilterList(t *testing.T) {
+	list, err := ParseFilterList(strings.NewReader(testFilterList))
+	if err != nil {
+		t.Fatalf("ParseFilterList: %v", err)
+	}
+	if list.Filters != 8 {
+		t.Errorf("Filters = %v, want 8", list.Filters)
+	}
+
+	testCases := []struct {
+		url          string
+		resourceType string
+		page         string
+		want         string // The filter blocking the request, if any.
+	}{
+		{"https://ads.example.com/ad.js", "Script", "https://news.example.org/", "||ads.example.com^"},
+		{"https://eu.ads.example.com/ad.js", "Script", "https://news.example.org/", "||ads.example.com^"},
+		{"https://ads.example.community/ad.js", "Script", "https://news.example.org/", ""},
+		{"https://ads.example.com/allowed/ad.js", "Script", "https://news.example.org/", ""},
+		{"https://tracker.net/pixel.gif", "Image", "https://news.example.org/", "||tracker.net^$third-party"},
+		{"https://tracker.net/pixel.gif", "Image", "https://www.tracker.net/", ""},
+		{"https://cdn.example.org/banner/top/img?id=1", "Image", "https://news.example.org/", "/banner/*/img^"},
+		{"https://cdn.example.org/banner/top/imgs.gif", "Image", "https://news.example.org/", ""},
+		{"http://plain.example.org/exact.js", "Script", "https://news.example.org/", "|http://plain.example.org/exact.js|"},
+		{"http://plain.example.org/exact.js?v=2", "Script", "https://news.example.org/", ""},
+		{"https://media.example.net/clip.mp4", "Media", "https://video.example.com/", ".mp4$media,domain=video.example.com|~safe.video.example.com"},
+		{"https://media.example.net/clip.mp4", "Media", "https://safe.video.example.com/", ""},
+		{"https://media.example.net/clip.mp4", "XHR", "https://video.example.com/", ""},
+		{"https://media.example.net/clip.mp4", "Media", "https://news.example.org/", ""},
+		{"https://cdn.example.com/Script.js", "Script", "https://news.example.org/", "||cdn.example.com/Script.js$script,match-case"},
+		{"https://cdn.example.com/script.js", "Script", "https://news.example.org/", ""},
+		{"https://cdn.example.com/Script.js", "Image", "https://news.example.org/", ""},
+		{"https://popup.example.com/", "Document", "https://news.example.org/", ""},
+		{"https://example.org/ad42.png", "Image", "https://news.example.org/", `/\/ad[0-9]+\.png$/`},
+	}
+	for _, tc := range testCases {
+		got := ""
+		if f := list.match(newInterceptedRequest(tc.url, tc.resourceType, tc.page)); f != nil {
+			got = f.text
+		}
+		if got != tc.want {
+			t.Errorf("the filter blocking the %v %v of %v = %q, want %q", tc.resourceType, tc.url, tc.page, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"streaming_hdp/devtools"
+)
+
+// The headers never forwarded to Chrome: the hop-by-hop headers, and the ones describing the request to the proxy.
+var unforwardedHeaders = []string{
+	"Connection", "Keep-Alive", "Proxy-Authenticate", "Proxy-Authorization", "Proxy-Connection",
+	"Te", "Trailer", "Transfer-Encoding", "Upgrade", "Host", "Content-Length",
+}
+
+// ForwardPolicy selects the headers of the requests of clients forwarded to Chrome when rendering their
+// pages, for previews to reflect the pages the clients would see. Header names are case insensitive,
+// and may end with * to match the headers starting with the rest, e.g. "Sec-CH-*". The zero value
+// forwards none.
+//
+// Cookies are set for the URL of the page, and Referer is the referrer of the navigation. Authorization
+// is only sent with the request of the page, not with the requests it makes. User-Agent and
+// Accept-Language override the ones of the emulated device. The other headers are sent with all the
+// requests of the page.
+type ForwardPolicy struct {
+	Allow []string // The headers forwarded.
+	Deny  []string // The headers never forwarded, even if allowed.
+}
+
+// DefaultForwardPolicy returns the policy forwarding the cookies, language, referrer, Save-Data and
+// client hints of clients.
+func DefaultForwardPolicy() ForwardPolicy {
+	return ForwardPolicy{
+		Allow: []string{
+			"Cookie", "Accept-Language", "Referer", "Save-Data", "Sec-CH-*",
+			"Device-Memory", "DPR", "Viewport-Width", "Downlink", "ECT", "RTT",
+		},
+	}
+}
+
+// Filter returns the headers of header forwarded by the policy.
+func (p ForwardPolicy) Filter(header http.Header) http.Header {
+	forwarded := make(http.Header)
+	for name, values := range header {
+		if matchHeader(name, p.Allow) && !matchHeader(name, p.Deny) && !matchHeader(name, unforwardedHeaders) {
+			forwarded[http.CanonicalHeaderKey(name)] = values
+		}
+	}
+	return forwarded
+}
+
+// matchHeader returns whether the header name matches one of the patterns.
+func matchHeader(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if prefix := strings.TrimSuffix(pattern, "*"); prefix != pattern {
+			if len(name) >= len(prefix) && strings.EqualFold(name[:len(prefix)], prefix) {
+				return true
+			}
+		} else if strings.EqualFold(name, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetClientHeaders sets the headers of the request of the client the next page is rendered for. The
+// ones forwarded by the ForwardPolicy of the launch options are sent by Chrome.
+func (c *Instance) SetClientHeaders(header http.Header) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.page.clientHeaders = c.options.Forward.Filter(header)
+}
+
+// forwardClientHeaders makes Chrome send the headers of the client with the requests of the page.
+// Returns the referrer of the navigation.
+func (c *Instance) forwardClientHeaders(page string) string {
+	c.mu.Lock()
+	header := c.page.clientHeaders
+	target := c.target
+	c.mu.Unlock()
+	if len(header) == 0 || target == nil {
+		return ""
+	}
+
+	extra := devtools.Params{}
+	for name, values := range header {
+		switch name {
+		case "Cookie", "Referer", "Authorization", "User-Agent", "Accept-Language":
+			// See startInterception and emulate for the last three.
+		default:
+			extra[name] = strings.Join(values, ", ")
+		}
+	}
+	if len(extra) > 0 {
+		target.InvokeMethod("Network.setExtraHTTPHeaders", devtools.Params{"headers": extra})
+	}
+	cookies := []devtools.Params{}
+	for _, cookie := range (&http.Request{Header: http.Header{"Cookie": header["Cookie"]}}).Cookies() {
+		cookies = append(cookies, devtools.Params{"name": cookie.Name, "value": cookie.Value, "url": page})
+	}
+	if len(cookies) > 0 {
+		target.InvokeMethod("Network.setCookies", devtools.Params{"cookies": cookies})
+	}
+	return header.Get("Referer")
+}
+
+// mainRequestHeaders returns the headers of the client only sent with the request of the page.
+func (c *Instance) mainRequestHeaders() http.Header {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if authorization := c.page.clientHeaders.Get("Authorization"); authorization != "" {
+		return http.Header{"Authorization": {authorization}}
+	}
+	return nil
+}
+
+// withHeaders returns the headers of a request paused by Fetch, with the extra ones.
+func withHeaders(request map[string]interface{}, extra http.Header) []devtools.Params {
+	var headers []devtools.Params
+	original, _ := request["headers"].(map[string]interface{})
+	for name, value := range original {
+		if extra.Get(name) == "" {
+			headers = append(headers, devtools.Params{"name": name, "value": value})
+		}
+	}
+	for name, values := range extra {
+		headers = append(headers, devtools.Params{"name": name, "value": strings.Join(values, ", ")})
+	}
+	sort.Slice(headers, func(i, j int) bool { return headers[i]["name"].(string) < headers[j]["name"].(string) })
+	return headers
+}
+
+// sameHost returns whether the URLs have the same scheme, host and port.
+func sameHost(a, b string) bool {
+	ua, erra := url.Parse(a)
+	ub, errb := url.Parse(b)
+	return erra == nil && errb == nil && ua.Scheme == ub.Scheme && strings.EqualFold(ua.Host, ub.Host)
+}
@@ -0,0 +1,125 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"streaming_hdp/devtools"
+	"streaming_hdp/devtools/cdptest"
+)
+
+func TestForwardPolicyFilter(t *testing.T) {
+	header := http.Header{
+		"Cookie":              {"a=1"},
+		"Sec-Ch-Ua-Mobile":    {"?1"},
+		"Authorization":       {"Basic dXNlcjpwYXNz"},
+		"Proxy-Authorization": {"Basic cHJveHk6cGFzcw=="},
+		"X-Forwarded-For":     {"10.0.0.1"},
+	}
+	got := DefaultForwardPolicy().Filter(header)
+	want := http.Header{"Cookie": {"a=1"}, "Sec-Ch-Ua-Mobile": {"?1"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DefaultForwardPolicy().Filter() = %v, want %v", got, want)
+	}
+
+	got = ForwardPolicy{Allow: []string{"*"}, Deny: []string{"x-forwarded-*", "Cookie"}}.Filter(header)
+	want = http.Header{"Sec-Ch-Ua-Mobile": {"?1"}, "Authorization": {"Basic dXNlcjpwYXNz"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Filter() allowing all = %v, want %v", got, want)
+	}
+	if got := (ForwardPolicy{}).Filter(header); len(got) != 0 {
+		t.Errorf("the zero ForwardPolicy forwarded %v", got)
+	}
+}
+
+func TestForwardClientHeaders(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.HandleResult("Page.getFrameTree", devtools.Params{"frameTree": devtools.Params{"frame": devtools.Params{"id": "main"}}})
+	chromeInstance := connectToFakeChrome(t, s)
+	defer chromeInstance.DisconnectAndTerminate()
+	chromeInstance.options.Forward = ForwardPolicy{Allow: []string{"Cookie", "Referer", "Save-Data", "Accept-Language", "User-Agent", "Authorization"}}
+	chromeInstance.SetClientHeaders(http.Header{
+		"Cookie":          {"session=abc; theme=dark"},
+		"Referer":         {"https://search.example.org/"},
+		"Save-Data":       {"on"},
+		"Accept-Language": {"fr-FR,fr;q=0.9"},
+		"User-Agent":      {"client-agent"},
+		"Authorization":   {"Bearer token"},
+		"Accept":          {"text/html"},
+	})
+	if _, err := chromeInstance.NavigateToPage("https://example.com/account"); err != nil {
+		t.Fatalf("NavigateToPage: %v", err)
+	}
+
+	navigate := waitForCalls(t, s, "Page.navigate", 1)
+	if referrer, _ := navigate[0].Params.String("referrer"); referrer != "https://search.example.org/" {
+		t.Errorf("referrer = %q, want https://search.example.org/", referrer)
+	}
+	cookies := s.CallsTo("Network.setCookies")
+	if len(cookies) != 1 {
+		t.Fatalf("Network.setCookies invoked %v times, want 1", len(cookies))
+	}
+	list, _ := cookies[0].Params["cookies"].([]interface{})
+	if len(list) != 2 {
+		t.Fatalf("cookies = %v, want session and theme", cookies[0].Params["cookies"])
+	}
+	if cookie := devtools.Params(list[0].(map[string]interface{})); cookie["name"] != "session" || cookie["value"] != "abc" || cookie["url"] != "https://example.com/account" {
+		t.Errorf("cookie = %v, want session=abc for https://example.com/account", cookie)
+	}
+	extra := s.CallsTo("Network.setExtraHTTPHeaders")
+	if len(extra) != 1 {
+		t.Fatalf("Network.setExtraHTTPHeaders invoked %v times, want 1", len(extra))
+	}
+	if headers, _ := extra[0].Params["headers"].(map[string]interface{}); !reflect.DeepEqual(headers, map[string]interface{}{"Save-Data": "on"}) {
+		t.Errorf("extra headers = %v, want only Save-Data", headers)
+	}
+	ua := s.CallsTo("Network.setUserAgentOverride")
+	if agent, _ := ua[0].Params.String("userAgent"); agent != "client-agent" {
+		t.Errorf("userAgent = %q, want client-agent", agent)
+	}
+	if language, _ := ua[0].Params.String("acceptLanguage"); language != "fr-FR,fr;q=0.9" {
+		t.Errorf("acceptLanguage = %q, want fr-FR,fr;q=0.9", language)
+	}
+
+	// Authorization is only sent with the request of the page.
+	enable := s.CallsTo("Fetch.enable")
+	if len(enable) != 1 {
+		t.Fatalf("Fetch.enable invoked %v times, want 1", len(enable))
+	}
+	paused := func(id, url string) devtools.EventMessage {
+		return devtools.EventMessage{Method: "Fetch.requestPaused", Params: devtools.Params{
+			"requestId":    id,
+			"request":      devtools.Params{"url": url, "headers": devtools.Params{"Accept": "text/html"}},
+			"resourceType": "Document",
+			"frameId":      "main",
+		}}
+	}
+	s.Emit(paused("1", "https://example.com/account"), paused("2", "https://login.example.net/"))
+	continued := waitForCalls(t, s, "Fetch.continueRequest", 2)
+	want := []interface{}{
+		map[string]interface{}{"name": "Accept", "value": "text/html"},
+		map[string]interface{}{"name": "Authorization", "value": "Bearer token"},
+	}
+	if headers := continued[0].Params["headers"]; !reflect.DeepEqual(headers, want) {
+		t.Errorf("the headers of the request of the page = %v, want %v", headers, want)
+	}
+	if headers, ok := continued[1].Params["headers"]; ok {
+		t.Errorf("the redirect to another host was sent the headers %v", headers)
+	}
+}
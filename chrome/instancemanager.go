@@ -18,80 +18,404 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"sync"
 	"time"
 
-	"github.com/phayes/freeport"
+	"streaming_hdp/devtools"
 )
 
 // Number of Chrome instances that we should have available.
 const numBufferedInstance = 15
 
+// The time between two checks for idle instances to terminate, when nothing else happens.
+const reapInterval = time.Second
+
+// DefaultDrainTimeout is how long Close waits for the instances in use by default. Instances in use
+// terminate on their own at the end of their lifecycle, see SetLifecycle.
+const DefaultDrainTimeout = 30 * time.Second
+
+var (
+	// ErrPoolExhausted is returned by AcquireInstance when no Chrome instance became available in time.
+	ErrPoolExhausted = errors.New("no Chrome instance available")
+	// ErrClosed is returned by AcquireInstance once the InstanceManager is closed.
+	ErrClosed = errors.New("instance manager closed")
+)
+
+// DebuggingTransport selects how the proxy exchanges DevTools messages with Chrome instances.
+type DebuggingTransport int
+
+const (
+	// PortTransport, the default, starts Chrome with --remote-debugging-port on a free local port, e.g.
+	// to inspect Chrome while debugging. Any local process can control Chrome. Instances controlled
+	// through a port have neither tabs nor resets: TabsPerProcess and recycling require PipeTransport.
+	PortTransport DebuggingTransport = iota
+	// PipeTransport starts Chrome with --remote-debugging-pipe. Only the proxy can control Chrome.
+	PipeTransport
+)
+
+// PoolOptions bounds the Chrome instances started by an InstanceManager.
+type PoolOptions struct {
+	MinIdle        int           // The number of instances kept started, waiting to be handed out.
+	MaxIdle        int           // The maximum number of instances waiting to be handed out. At least MinIdle.
+	MaxTotal       int           // The maximum number of instances, starting, waiting or in use. Zero means no limit.
+	SpawnInterval  time.Duration // The minimum time between starting two instances.
+	IdleTimeout    time.Duration // Instances beyond MinIdle waiting for longer are terminated. Zero means never.
+	AcquireTimeout time.Duration // How long AcquireInstance waits when no instance is idle. Zero rejects right away.
+	// TabsPerProcess is the number of instances sharing one Chrome process, each being a tab in its own
+	// browser context. Zero or one starts a Chrome process per instance. Requires PipeTransport.
+	TabsPerProcess int
+}
+
+// DefaultPoolOptions returns the pool options of a new InstanceManager.
+func DefaultPoolOptions() PoolOptions {
+	return PoolOptions{
+		MinIdle:        numBufferedInstance,
+		MaxIdle:        numBufferedInstance,
+		MaxTotal:       4 * numBufferedInstance,
+		SpawnInterval:  100 * time.Millisecond,
+		IdleTimeout:    10 * time.Minute,
+		AcquireTimeout: 30 * time.Second,
+	}
+}
+
+// RecycleOptions configures the reuse of Chrome instances once their page is done, instead of
+// terminating them. Instances are reset between pages: see Instance.Reset.
+type RecycleOptions struct {
+	MaxNavigations int   // The number of pages an instance renders before being terminated. Zero or one disables recycling. Requires PipeTransport.
+	MaxMemory      int64 // If set, instances using more memory, in bytes, are terminated instead of reused.
+}
+
+// idleInstance is an instance waiting to be handed out.
+type idleInstance struct {
+	id    int       // The ID of the instance.
+	since time.Time // When the instance started waiting.
+}
+
 // InstanceManager manages Chrome instances.
 type InstanceManager struct {
-	nextInstanceID int      // The next instance ID for Chrome.
-	instanceQueue  chan int // The queue for sending back the instances.
+	backend Backend                   // Provides the Chrome instances.
+	launch  func() (*Instance, error) // Starts and connects to an instance of Chrome, with the backend.
+
+	browsersMutex sync.Mutex  // Protects browsers, and serializes the creation of tabs.
+	browsers      []*Instance // The Chrome processes hosting the tabs handed out when PoolOptions.TabsPerProcess is set.
 
-	instancesMutex sync.Mutex        // Protects the following fields.
-	instances      map[int]*Instance // Holds a mapping from instance ID to a reference of the Chrome instance.
-	urls           map[int]string    // Holds a mapping from instance ID to the URL.
-	useFullChrome  bool              // Whether to start Chrome with GUI.
+	instancesMutex sync.Mutex                // Protects the following fields.
+	nextInstanceID int                       // The next instance ID for Chrome.
+	instances      map[int]*Instance         // Holds a mapping from instance ID to a reference of the Chrome instance.
+	urls           map[int]string            // Holds a mapping from instance ID to the URL.
+	idle           []idleInstance            // The instances waiting to be handed out, the oldest first.
+	starting       int                       // The number of instances being started.
+	waiting        int                       // The number of callers waiting for an instance.
+	launchFailures int                       // The number of instances that failed to start or connect.
+	changed        chan struct{}             // Closed, then replaced, whenever the fields above change.
+	pool           PoolOptions               // Bounds the instances.
+	recordDir      string                    // The directory the DevTools messages of each instance are recorded to, if set.
+	keepalive      devtools.KeepaliveOptions // How instances are probed while they wait to be handed out.
+	recycle        RecycleOptions            // How instances are reused once released.
+	lifecycle      LifecycleOptions          // How long instances handed out are used before being terminated.
+	closed         bool                      // Whether Close was called: no instance is started or handed out anymore.
 }
 
-// NewInstanceManager creates a new instance manager.
+// NewInstanceManager creates a new instance manager, controlling Chrome instances through DevTools ports.
+// Use NewInstanceManagerWithTransport to control them through pipes instead.
 func NewInstanceManager(useFullChrome bool) *InstanceManager {
-	newInstanceManager := InstanceManager{
-		nextInstanceID: 0,
-		instances:      make(map[int]*Instance),
-		urls:           make(map[int]string),
-		useFullChrome:  useFullChrome,
-		instanceQueue:  make(chan int, numBufferedInstance),
+	return NewInstanceManagerWithTransport(useFullChrome, PortTransport)
+}
+
+// NewInstanceManagerWithTransport creates a new instance manager, controlling Chrome instances through transport.
+func NewInstanceManagerWithTransport(useFullChrome bool, transport DebuggingTransport) *InstanceManager {
+	return NewInstanceManagerWithOptions(LaunchOptions{UseFullChrome: useFullChrome}, transport)
+}
+
+// NewInstanceManagerWithOptions creates a new instance manager, starting Chrome instances with opts
+// and controlling them through transport. The instances are bounded by DefaultPoolOptions, see SetPool.
+func NewInstanceManagerWithOptions(opts LaunchOptions, transport DebuggingTransport) *InstanceManager {
+	return NewInstanceManagerWithBackend(NewLocalBackend(opts, transport))
+}
+
+// NewInstanceManagerWithBackend creates a new instance manager, handing out the Chrome instances
+// provided by backend, e.g. a RemoteBackend. The backend is closed along with the manager.
+func NewInstanceManagerWithBackend(backend Backend) *InstanceManager {
+	im := newInstanceManager(backend)
+	go im.maintainPool()
+	return im
+}
+
+// newInstanceManager creates an instance manager, without starting instances.
+func newInstanceManager(backend Backend) *InstanceManager {
+	im := &InstanceManager{
+		backend:   backend,
+		instances: make(map[int]*Instance),
+		urls:      make(map[int]string),
+		changed:   make(chan struct{}),
+		pool:      DefaultPoolOptions(),
+		lifecycle: DefaultLifecycleOptions(),
 	}
+	im.launch = backend.Launch
+	return im
+}
 
-	go func() {
-		for {
-			newInstanceManager.addInstance(useFullChrome)
+// SetPool sets the bounds of the Chrome instances. Idle instances beyond the new bounds are terminated.
+func (im *InstanceManager) SetPool(opts PoolOptions) {
+	im.instancesMutex.Lock()
+	defer im.instancesMutex.Unlock()
+	im.pool = opts
+	im.notifyLocked()
+}
+
+// notifyLocked wakes up the goroutines waiting for the instances to change. im.instancesMutex must be held.
+func (im *InstanceManager) notifyLocked() {
+	close(im.changed)
+	im.changed = make(chan struct{})
+}
+
+// maintainPool starts instances until MinIdle of them are idle, or callers are waiting for
+// instances, and terminates the idle instances beyond the bounds of the pool.
+func (im *InstanceManager) maintainPool() {
+	var lastSpawn time.Time
+	for {
+		im.instancesMutex.Lock()
+		if im.closed {
+			im.instancesMutex.Unlock()
+			return
 		}
-	}()
-	return &newInstanceManager
+		terminate := im.sweepLocked()
+		terminate = append(terminate, im.reapIdleLocked(time.Now())...)
+		wait := reapInterval
+		spawn := false
+		if im.shouldSpawnLocked() {
+			if untilNext := im.pool.SpawnInterval - time.Since(lastSpawn); untilNext > 0 {
+				wait = untilNext
+			} else {
+				im.starting++
+				spawn = true
+			}
+		}
+		changed := im.changed
+		im.instancesMutex.Unlock()
+
+		terminateAll(terminate)
+		if spawn {
+			lastSpawn = time.Now()
+			go im.addInstance()
+			continue
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-changed:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+// shouldSpawnLocked returns whether another instance should be started. im.instancesMutex must be held.
+func (im *InstanceManager) shouldSpawnLocked() bool {
+	if im.pool.MaxTotal > 0 && len(im.instances)+im.starting >= im.pool.MaxTotal {
+		return false
+	}
+	return len(im.idle)+im.starting < im.pool.MinIdle+im.waiting
+}
+
+// sweepLocked forgets the instances handed out then terminated, e.g. by their timeout, without
+// being removed. Returns them. im.instancesMutex must be held.
+func (im *InstanceManager) sweepLocked() []*Instance {
+	var swept []*Instance
+	for id := range im.urls {
+		if chromeInstance, ok := im.instances[id]; !ok || chromeInstance.terminated() {
+			delete(im.instances, id)
+			delete(im.urls, id)
+			if ok {
+				swept = append(swept, chromeInstance)
+			}
+		}
+	}
+	return swept
 }
 
-// AddInstance adds an instance to the instance manager. Returns -1, if there is an error.
-func (im *InstanceManager) addInstance(useFullChrome bool) {
+// reapIdleLocked removes the idle instances beyond MaxIdle, and the ones beyond MinIdle idle for
+// longer than IdleTimeout. Returns them. im.instancesMutex must be held.
+func (im *InstanceManager) reapIdleLocked(now time.Time) []*Instance {
+	maxIdle := im.pool.MaxIdle
+	if maxIdle < im.pool.MinIdle {
+		maxIdle = im.pool.MinIdle
+	}
+	var reaped []*Instance
+	for len(im.idle) > im.pool.MinIdle {
+		oldest := im.idle[0]
+		expired := im.pool.IdleTimeout > 0 && now.Sub(oldest.since) >= im.pool.IdleTimeout
+		if len(im.idle) <= maxIdle && !expired {
+			break
+		}
+		im.idle = im.idle[1:]
+		if chromeInstance, ok := im.instances[oldest.id]; ok {
+			delete(im.instances, oldest.id)
+			reaped = append(reaped, chromeInstance)
+		}
+	}
+	if len(reaped) > 0 {
+		fmt.Printf("terminating %v idle chrome instances\n", len(reaped))
+		im.notifyLocked()
+	}
+	return reaped
+}
+
+// AddInstance adds an instance to the instance manager, once it has started. Returns -1, if there is an error.
+// The caller must have counted the instance in im.starting.
+func (im *InstanceManager) addInstance() int {
 	im.instancesMutex.Lock()
-	id := im.nextInstanceID
-	im.nextInstanceID++
+	tabsPerProcess := im.pool.TabsPerProcess
 	im.instancesMutex.Unlock()
 
-	// Start and connect to an instance of Chrome.
-	chromePort, err := freeport.GetFreePort()
-	if err != nil {
-		fmt.Printf("failed to get an unused port\n")
-		return
+	var chromeInstance *Instance
+	var err error
+	if tabsPerProcess > 1 {
+		chromeInstance, err = im.newTab(tabsPerProcess)
+	} else {
+		chromeInstance, err = im.launch()
 	}
-
-	// We don't care about the ID of Chrome in this case.
-	chromeInstance, err := New(chromePort, useFullChrome)
 	if err != nil {
-		fmt.Printf("failed to create an instance of chrome\n")
-		return
+		im.instancesMutex.Lock()
+		im.launchFailures++
+		im.instancesMutex.Unlock()
+	}
+	id := im.addStartedInstance(chromeInstance, err)
+	if id >= 0 {
+		im.watch(chromeInstance)
 	}
+	return id
+}
 
+// addStartedInstance adds the instance to the idle instances, unless it failed to start. Returns
+// its ID, or -1. The caller must have counted the instance in im.starting.
+func (im *InstanceManager) addStartedInstance(chromeInstance *Instance, err error) int {
 	im.instancesMutex.Lock()
+	im.starting--
+	if err != nil || im.closed {
+		im.notifyLocked()
+		im.instancesMutex.Unlock()
+		if err == nil {
+			chromeInstance.DisconnectAndTerminate()
+		}
+		return -1
+	}
+	id := im.nextInstanceID
+	im.nextInstanceID++
 	im.instances[id] = chromeInstance
+	im.idle = append(im.idle, idleInstance{id: id, since: time.Now()})
+	im.notifyLocked()
 	im.instancesMutex.Unlock()
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	return id
+}
+
+// newTab returns a tab in its own browser context, in a Chrome process hosting less than tabsPerProcess
+// tabs. A Chrome process is started if there is none.
+func (im *InstanceManager) newTab(tabsPerProcess int) (*Instance, error) {
+	im.browsersMutex.Lock()
+	defer im.browsersMutex.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), methodTimeout)
 	defer cancel()
-	if err := chromeInstance.Wait(ctx); err != nil {
-		fmt.Printf("got an error starting chrome: %v\n", err)
-		return
+
+	// Forget the Chrome processes terminated along with their last tab.
+	browsers := im.browsers[:0]
+	for _, browser := range im.browsers {
+		if !browser.terminated() {
+			browsers = append(browsers, browser)
+		}
 	}
-	err = chromeInstance.Connect()
+	im.browsers = browsers
+
+	for _, browser := range im.browsers {
+		if browser.Tabs() >= tabsPerProcess {
+			continue
+		}
+		tab, err := browser.NewTab(ctx)
+		if err == nil {
+			return tab, nil
+		}
+		// The process may have been terminated with its last tab meanwhile: use another one.
+		fmt.Printf("failed to open a tab in chrome: %v\n", err)
+	}
+
+	browser, err := im.launch()
 	if err != nil {
-		fmt.Printf("chrome instance failed to connect to DevTools: %v\n", err)
-		return
+		return nil, err
+	}
+	tab, err := browser.NewTab(ctx)
+	if err != nil {
+		fmt.Printf("failed to open a tab in a new chrome: %v\n", err)
+		browser.DisconnectAndTerminate()
+		return nil, err
+	}
+	im.browsers = append(im.browsers, browser)
+	return tab, nil
+}
+
+// watch probes the instance, and retires it if it stops responding or dies before being handed out,
+// letting the pool start a replacement.
+func (im *InstanceManager) watch(chromeInstance *Instance) {
+	go func() {
+		<-chromeInstance.Dead()
+		im.retireIdleInstance(chromeInstance, chromeInstance.Err())
+	}()
+
+	im.instancesMutex.Lock()
+	opts := im.keepalive
+	im.instancesMutex.Unlock()
+	// Recycled instances get a new ID: the probes outlive the ID the instance had when it started.
+	opts.OnUnhealthy = func(err error) {
+		im.retireIdleInstance(chromeInstance, err)
 	}
-	im.instanceQueue <- id
+	chromeInstance.StartKeepalive(opts)
+}
+
+// retireIdleInstance terminates the instance if it has not been handed out yet.
+// Instances in use are left to the caller of GetNewInstance and their timeout.
+func (im *InstanceManager) retireIdleInstance(chromeInstance *Instance, err error) {
+	im.instancesMutex.Lock()
+	for i, idle := range im.idle {
+		if im.instances[idle.id] == chromeInstance {
+			delete(im.instances, idle.id)
+			im.idle = append(im.idle[:i:i], im.idle[i+1:]...)
+			im.notifyLocked()
+			im.instancesMutex.Unlock()
+			fmt.Printf("retiring idle chrome instance %v: %v\n", idle.id, err)
+			chromeInstance.DisconnectAndTerminate()
+			return
+		}
+	}
+	im.instancesMutex.Unlock()
+}
+
+// SetKeepalive sets how instances are probed to detect when they stop responding.
+// Unhealthy instances are retired instead of being handed out by GetNewInstance.
+func (im *InstanceManager) SetKeepalive(opts devtools.KeepaliveOptions) {
+	im.instancesMutex.Lock()
+	defer im.instancesMutex.Unlock()
+	im.keepalive = opts
+}
+
+// SetRecycle sets how instances are reused once released. See ReleaseInstance.
+func (im *InstanceManager) SetRecycle(opts RecycleOptions) {
+	im.instancesMutex.Lock()
+	defer im.instancesMutex.Unlock()
+	im.recycle = opts
+}
+
+// SetLifecycle sets how long the instances handed out are used before being terminated. See Instance.Begin.
+func (im *InstanceManager) SetLifecycle(opts LifecycleOptions) {
+	im.instancesMutex.Lock()
+	defer im.instancesMutex.Unlock()
+	im.lifecycle = opts
+}
+
+// SetRecordDir records the DevTools messages of every instance handed out by GetNewInstance
+// to a file in dir, for replaying problematic sessions. An empty dir stops recording.
+func (im *InstanceManager) SetRecordDir(dir string) {
+	im.instancesMutex.Lock()
+	defer im.instancesMutex.Unlock()
+	im.recordDir = dir
 }
 
 // GetURL returns the URL associated to the instanceID.
@@ -108,14 +432,238 @@ func (im *InstanceManager) GetURL(instanceID int) (string, error) {
 // GetNewInstance returns a Chrome instance and registers the URL to
 // the instance. The caller is responsible to call WaitUntilChromeReady()
 // to ensure that Chrome is usable. This call also starts the timer
-// for the next chrome instance.
+// for the next chrome instance. Instances that stopped responding
+// while waiting to be handed out are skipped. Blocks until an instance
+// is available: see AcquireInstance to bound the wait.
 func (im *InstanceManager) GetNewInstance(url string) int {
-	nextInstanceID := <-im.instanceQueue
+	id, _ := im.acquire(url, time.Time{})
+	return id
+}
+
+// AcquireInstance is like GetNewInstance, but waits at most PoolOptions.AcquireTimeout for
+// an instance when none is idle. Returns ErrPoolExhausted if none became available in time.
+func (im *InstanceManager) AcquireInstance(url string) (int, error) {
+	im.instancesMutex.Lock()
+	deadline := time.Now().Add(im.pool.AcquireTimeout)
+	im.instancesMutex.Unlock()
+	return im.acquire(url, deadline)
+}
+
+// acquire hands out the oldest healthy idle instance for the URL, waiting for one until the
+// deadline. A zero deadline waits forever.
+func (im *InstanceManager) acquire(url string, deadline time.Time) (int, error) {
 	im.instancesMutex.Lock()
 	defer im.instancesMutex.Unlock()
-	im.urls[nextInstanceID] = url
-	im.instances[nextInstanceID].InitializeTimeout()
-	return nextInstanceID
+	im.waiting++
+	im.notifyLocked()
+	defer func() { im.waiting-- }()
+
+	for {
+		if im.closed {
+			return -1, ErrClosed
+		}
+		for len(im.idle) > 0 {
+			next := im.idle[0]
+			im.idle = im.idle[1:]
+			chromeInstance, ok := im.instances[next.id]
+			if ok && chromeInstance.Err() == nil && chromeInstance.Healthy() {
+				im.handOutLocked(next.id, url)
+				im.notifyLocked()
+				return next.id, nil
+			}
+			// The instance was retired, is wedged or died: never hand it out.
+			delete(im.instances, next.id)
+			im.notifyLocked()
+			if ok {
+				fmt.Printf("skipping unhealthy chrome instance %v\n", next.id)
+				go chromeInstance.DisconnectAndTerminate()
+			}
+		}
+
+		if !deadline.IsZero() && !time.Now().Before(deadline) {
+			fmt.Printf("no chrome instance available for %v\n", url)
+			return -1, ErrPoolExhausted
+		}
+		changed := im.changed
+		im.instancesMutex.Unlock()
+		waitUntil(changed, deadline)
+		im.instancesMutex.Lock()
+	}
+}
+
+// waitUntil waits until changed is closed, or the deadline if it is not zero.
+func waitUntil(changed <-chan struct{}, deadline time.Time) {
+	if deadline.IsZero() {
+		<-changed
+		return
+	}
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+	select {
+	case <-changed:
+	case <-timer.C:
+	}
+}
+
+// handOutLocked registers the URL to the instance and begins its lifecycle. im.instancesMutex must be held.
+func (im *InstanceManager) handOutLocked(id int, url string) {
+	im.urls[id] = url
+	im.instances[id].Begin(context.Background(), im.lifecycle)
+	if im.recordDir != "" {
+		path := filepath.Join(im.recordDir, fmt.Sprintf("%v-instance-%d.jsonl", time.Now().Format("20060102-150405"), id))
+		if err := im.instances[id].RecordTo(path); err != nil {
+			fmt.Printf("failed to record chrome instance %v: %v\n", id, err)
+		} else {
+			fmt.Printf("Recording chrome instance %v for %v to %v\n", id, url, path)
+		}
+	}
+}
+
+// Close stops starting instances, and terminates the idle ones. It then waits for the instances in
+// use to be removed, or to terminate, until ctx is done, and terminates the remaining ones. Returns
+// ctx.Err() if instances had to be terminated while in use. Calling Close again waits for the drain again.
+func (im *InstanceManager) Close(ctx context.Context) error {
+	im.instancesMutex.Lock()
+	if !im.closed {
+		fmt.Printf("closing the instance manager: %v instances in use\n", len(im.urls))
+	}
+	im.closed = true
+	var idle []*Instance
+	for _, next := range im.idle {
+		if chromeInstance, ok := im.instances[next.id]; ok {
+			delete(im.instances, next.id)
+			idle = append(idle, chromeInstance)
+		}
+	}
+	im.idle = nil
+	im.notifyLocked()
+	im.instancesMutex.Unlock()
+	terminateAll(idle)
+
+	ticker := time.NewTicker(reapInterval)
+	defer ticker.Stop()
+	for {
+		im.instancesMutex.Lock()
+		swept := im.sweepLocked()
+		remaining := len(im.instances) + im.starting
+		changed := im.changed
+		im.instancesMutex.Unlock()
+		terminateAll(swept)
+		if remaining == 0 {
+			im.terminateBrowsers()
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-ticker.C:
+		case <-ctx.Done():
+			im.instancesMutex.Lock()
+			var inUse []*Instance
+			for id, chromeInstance := range im.instances {
+				inUse = append(inUse, chromeInstance)
+				delete(im.instances, id)
+				delete(im.urls, id)
+			}
+			im.notifyLocked()
+			im.instancesMutex.Unlock()
+			fmt.Printf("terminating %v chrome instances still in use\n", len(inUse))
+			terminateAll(inUse)
+			im.terminateBrowsers()
+			// Instances still starting are terminated as soon as they have started.
+			return ctx.Err()
+		}
+	}
+}
+
+// terminateBrowsers terminates the Chrome processes still hosting tabs, e.g. tabs that were starting
+// when Close gave up waiting, and closes the backend. Chrome processes are otherwise terminated along
+// with their last tab.
+func (im *InstanceManager) terminateBrowsers() {
+	im.browsersMutex.Lock()
+	browsers := im.browsers
+	im.browsers = nil
+	im.browsersMutex.Unlock()
+	terminateAll(browsers)
+	if err := im.backend.Close(); err != nil {
+		fmt.Printf("failed to close the chrome backend: %v\n", err)
+	}
+}
+
+// terminateAll disconnects from and terminates the instances.
+func terminateAll(instances []*Instance) {
+	for _, chromeInstance := range instances {
+		chromeInstance.DisconnectAndTerminate()
+	}
+}
+
+// ReleaseInstance removes the instance from the manager once its page is done. If recycling is
+// enabled with SetRecycle and the instance is within its bounds, the instance is reset in the
+// background and handed out again under a new ID. It is terminated otherwise.
+func (im *InstanceManager) ReleaseInstance(instanceID int) error {
+	im.instancesMutex.Lock()
+	chromeInstance, ok := im.instances[instanceID]
+	if !ok {
+		im.instancesMutex.Unlock()
+		return errors.New("instance with this ID does not exist")
+	}
+	delete(im.instances, instanceID)
+	delete(im.urls, instanceID)
+	opts := im.recycle
+	maxLifetime := im.lifecycle.MaxLifetime
+	im.notifyLocked()
+	im.instancesMutex.Unlock()
+	// The page is done: its request going away must not terminate the instance anymore.
+	chromeInstance.finishPage()
+
+	if maxLifetime > 0 && chromeInstance.Age() >= maxLifetime {
+		fmt.Printf("chrome instance %v reached its maximum lifetime: not recycling it\n", instanceID)
+	} else if recyclable(chromeInstance, opts) {
+		im.instancesMutex.Lock()
+		recycle := !im.closed
+		if recycle {
+			// The instance is accounted as starting while it is reset.
+			im.starting++
+		}
+		im.instancesMutex.Unlock()
+		if recycle {
+			go im.recycleInstance(chromeInstance)
+			return nil
+		}
+	}
+	return chromeInstance.DisconnectAndTerminate()
+}
+
+// recyclable returns whether the instance can render another page.
+func recyclable(chromeInstance *Instance, opts RecycleOptions) bool {
+	if chromeInstance.Navigations() >= opts.MaxNavigations || !chromeInstance.resettable() {
+		return false
+	}
+	if opts.MaxMemory > 0 {
+		usage, err := chromeInstance.MemoryUsage()
+		if err != nil {
+			fmt.Printf("failed to measure the memory of chrome: %v\n", err)
+			return false
+		}
+		if usage > opts.MaxMemory {
+			fmt.Printf("chrome uses %v bytes of memory, more than %v: not recycling it\n", usage, opts.MaxMemory)
+			return false
+		}
+	}
+	return true
+}
+
+// recycleInstance resets the instance and adds it back to the idle instances under a new ID.
+// The caller must have counted the instance in im.starting.
+func (im *InstanceManager) recycleInstance(chromeInstance *Instance) {
+	ctx, cancel := context.WithTimeout(context.Background(), methodTimeout)
+	defer cancel()
+	err := chromeInstance.Reset(ctx)
+	if err != nil {
+		fmt.Printf("failed to reset chrome instance: %v\n", err)
+		chromeInstance.DisconnectAndTerminate()
+	}
+	im.addStartedInstance(chromeInstance, err)
 }
 
 // GetInstance returns the Chrome instance associated to the instanceID.
@@ -140,5 +688,6 @@ func (im *InstanceManager) RemoveInstance(instanceID int) error {
 	}
 	delete(im.instances, instanceID)
 	delete(im.urls, instanceID)
+	im.notifyLocked()
 	return nil
 }
@@ -0,0 +1,454 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"streaming_hdp/devtools"
+	"streaming_hdp/devtools/cdptest"
+)
+
+// newFakeInstanceManager returns an InstanceManager whose instances connect to the fake Chrome.
+// The pool is not maintained: instances are only added by addFakeInstance, unless maintainPool is started.
+func newFakeInstanceManager(s *cdptest.Server) *InstanceManager {
+	im := newInstanceManager(NewLocalBackend(LaunchOptions{}, PortTransport))
+	im.keepalive = devtools.KeepaliveOptions{Interval: 10 * time.Millisecond, MaxMissed: 2}
+	im.launch = fakeLaunch(s)
+	return im
+}
+
+// fakeLaunch returns a function connecting new instances to the fake Chrome, without a Chrome process.
+func fakeLaunch(s *cdptest.Server) func() (*Instance, error) {
+	return func() (*Instance, error) {
+		chromeInstance := &Instance{
+			port:  s.Listener.Addr().(*net.TCPAddr).Port,
+			ready: make(chan bool),
+		}
+		if err := chromeInstance.Connect(); err != nil {
+			return nil, err
+		}
+		return chromeInstance, nil
+	}
+}
+
+// fakePipeLaunch returns a function connecting new instances to the fake Chrome through pipes,
+// as with NewWithPipe, without a Chrome process.
+func fakePipeLaunch(s *cdptest.Server) func() (*Instance, error) {
+	return func() (*Instance, error) {
+		chromeIn, pipeIn, err := os.Pipe()
+		if err != nil {
+			return nil, err
+		}
+		pipeOut, chromeOut, err := os.Pipe()
+		if err != nil {
+			return nil, err
+		}
+		go s.ServePipe(chromeIn, chromeOut)
+		chromeInstance := &Instance{
+			pipeIn:  pipeIn,
+			pipeOut: pipeOut,
+			ready:   make(chan bool, 1),
+		}
+		if err := chromeInstance.Connect(); err != nil {
+			return nil, err
+		}
+		return chromeInstance, nil
+	}
+}
+
+// addFakeInstance adds an idle instance connected to the fake Chrome.
+func (im *InstanceManager) addFakeInstance(t *testing.T) (int, *Instance) {
+	im.instancesMutex.Lock()
+	im.starting++
+	im.instancesMutex.Unlock()
+	id := im.addInstance()
+	chromeInstance, err := im.GetInstance(id)
+	if err != nil {
+		t.Fatalf("failed to add an instance connected to the fake Chrome: %v", err)
+	}
+	return id, chromeInstance
+}
+
+// countInstances returns the number of idle instances and the total number of instances.
+func (im *InstanceManager) countInstances() (idle, total int) {
+	im.instancesMutex.Lock()
+	defer im.instancesMutex.Unlock()
+	return len(im.idle), len(im.instances) + im.starting
+}
+
+// waitForInstances waits until the manager has the number of idle instances and of instances in total.
+func waitForInstances(t *testing.T, im *InstanceManager, wantIdle, wantTotal int) {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		idle, total := im.countInstances()
+		if idle == wantIdle && total == wantTotal {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("got %v idle instances out of %v, want %v out of %v", idle, total, wantIdle, wantTotal)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// closeInstanceManager closes the manager, terminating its instances right away.
+func closeInstanceManager(t *testing.T, im *InstanceManager) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	im.Close(ctx)
+	if _, total := im.countInstances(); total != 0 {
+		t.Errorf("%v instances left after Close", total)
+	}
+}
+
+// Test that instances that stop responding are retired before being handed out.
+func TestRetireUnhealthyInstances(t *testing.T) {
+	wedged := cdptest.NewServer()
+	defer wedged.Close()
+	healthy := cdptest.NewServer()
+	defer healthy.Close()
+
+	im := newFakeInstanceManager(wedged)
+	wedgedID, wedgedInstance := im.addFakeInstance(t)
+	im.launch = fakeLaunch(healthy)
+	healthyID, healthyInstance := im.addFakeInstance(t)
+	defer healthyInstance.DisconnectAndTerminate()
+	wedged.SetUnresponsive(true)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, err := im.GetInstance(wedgedID); err != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("the wedged instance was not retired")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if wedgedInstance.Healthy() {
+		t.Errorf("the retired instance is still healthy")
+	}
+
+	if id := im.GetNewInstance("http://example.com/"); id != healthyID {
+		t.Errorf("GetNewInstance() = %v, want the healthy instance %v", id, healthyID)
+	}
+	if url, err := im.GetURL(healthyID); err != nil || url != "http://example.com/" {
+		t.Errorf("GetURL(%v) = %v, %v, want http://example.com/", healthyID, url, err)
+	}
+}
+
+// Test that instances in use are not retired: their timeout takes care of them.
+func TestKeepInstancesInUse(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+
+	im := newFakeInstanceManager(s)
+	id, chromeInstance := im.addFakeInstance(t)
+	defer chromeInstance.DisconnectAndTerminate()
+	if got := im.GetNewInstance("http://example.com/"); got != id {
+		t.Fatalf("GetNewInstance() = %v, want %v", got, id)
+	}
+
+	s.SetUnresponsive(true)
+	deadline := time.Now().Add(5 * time.Second)
+	for chromeInstance.Healthy() {
+		if time.Now().After(deadline) {
+			t.Fatalf("the instance did not become unhealthy")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if _, err := im.GetInstance(id); err != nil {
+		t.Errorf("GetInstance(%v): %v, instances in use should not be retired", id, err)
+	}
+}
+
+// Test that the pool keeps MinIdle instances started, and no more.
+func TestPoolKeepsMinIdle(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	im := newFakeInstanceManager(s)
+	im.SetPool(PoolOptions{MinIdle: 2, MaxIdle: 2, MaxTotal: 3})
+	defer closeInstanceManager(t, im)
+	go im.maintainPool()
+
+	waitForInstances(t, im, 2, 2)
+	id, err := im.AcquireInstance("http://example.com/")
+	if err != nil {
+		t.Fatalf("AcquireInstance: %v", err)
+	}
+	// The instance handed out is replaced.
+	waitForInstances(t, im, 2, 3)
+	im.RemoveInstance(id)
+	waitForInstances(t, im, 2, 2)
+}
+
+// Test that callers are rejected once MaxTotal instances are in use, until one is removed.
+func TestPoolExhausted(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	im := newFakeInstanceManager(s)
+	im.SetPool(PoolOptions{MinIdle: 1, MaxIdle: 1, MaxTotal: 1, AcquireTimeout: 50 * time.Millisecond})
+	defer closeInstanceManager(t, im)
+	go im.maintainPool()
+
+	// Wait for the first instance to start.
+	id, err := im.acquire("http://example.com/", time.Now().Add(5*time.Second))
+	if err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+	if _, err := im.AcquireInstance("http://example.com/other"); err != ErrPoolExhausted {
+		t.Fatalf("AcquireInstance with every instance in use: %v, want %v", err, ErrPoolExhausted)
+	}
+	waitForInstances(t, im, 0, 1)
+
+	// A waiting caller gets the instance started once one is removed.
+	acquired := make(chan error)
+	go func() {
+		_, err := im.acquire("http://example.com/other", time.Now().Add(5*time.Second))
+		acquired <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	chromeInstance, _ := im.GetInstance(id)
+	chromeInstance.DisconnectAndTerminate()
+	im.RemoveInstance(id)
+	if err := <-acquired; err != nil {
+		t.Errorf("acquire after an instance was removed: %v", err)
+	}
+}
+
+// Test that idle instances beyond the bounds of the pool are terminated.
+func TestPoolReapsIdleInstances(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	im := newFakeInstanceManager(s)
+	im.SetPool(PoolOptions{MinIdle: 3, MaxIdle: 3})
+	defer closeInstanceManager(t, im)
+	go im.maintainPool()
+	waitForInstances(t, im, 3, 3)
+
+	im.SetPool(PoolOptions{MinIdle: 1, MaxIdle: 2})
+	waitForInstances(t, im, 2, 2)
+	im.SetPool(PoolOptions{MinIdle: 1, MaxIdle: 2, IdleTimeout: time.Millisecond})
+	waitForInstances(t, im, 1, 1)
+}
+
+// Test that Close waits for the instances in use to be removed.
+func TestCloseDrainsInstancesInUse(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	im := newFakeInstanceManager(s)
+	// The oldest idle instance is handed out first.
+	usedID, usedInstance := im.addFakeInstance(t)
+	idleID, idleInstance := im.addFakeInstance(t)
+	if id := im.GetNewInstance("http://example.com/"); id != usedID {
+		t.Fatalf("GetNewInstance() = %v, want %v", id, usedID)
+	}
+
+	closed := make(chan error)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		closed <- im.Close(ctx)
+	}()
+	waitForInstances(t, im, 0, 1)
+	if !idleInstance.terminated() {
+		t.Errorf("the idle instance %v was not terminated", idleID)
+	}
+	if usedInstance.terminated() {
+		t.Errorf("the instance in use %v was terminated before its drain period", usedID)
+	}
+	if _, err := im.AcquireInstance("http://example.com/other"); err != ErrClosed {
+		t.Errorf("AcquireInstance after Close: %v, want %v", err, ErrClosed)
+	}
+
+	usedInstance.DisconnectAndTerminate()
+	im.RemoveInstance(usedID)
+	if err := <-closed; err != nil {
+		t.Errorf("Close: %v", err)
+	}
+}
+
+// Test that Close terminates the instances still in use at the end of the drain period.
+func TestCloseTerminatesInstancesInUse(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	im := newFakeInstanceManager(s)
+	_, chromeInstance := im.addFakeInstance(t)
+	im.GetNewInstance("http://example.com/")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := im.Close(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Close: %v, want %v", err, context.DeadlineExceeded)
+	}
+	if !chromeInstance.terminated() {
+		t.Errorf("the instance in use was not terminated")
+	}
+	if _, total := im.countInstances(); total != 0 {
+		t.Errorf("%v instances left after Close", total)
+	}
+}
+
+// Test that the pool stops starting instances once closed.
+func TestCloseStopsPool(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	im := newFakeInstanceManager(s)
+	im.SetPool(PoolOptions{MinIdle: 2, MaxIdle: 2})
+	go im.maintainPool()
+	waitForInstances(t, im, 2, 2)
+
+	closeInstanceManager(t, im)
+	time.Sleep(50 * time.Millisecond)
+	if _, total := im.countInstances(); total != 0 {
+		t.Errorf("%v instances started after Close", total)
+	}
+}
+
+// Test that released instances are reset and handed out again, up to MaxNavigations pages.
+func TestRecycleInstances(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	im := newFakeInstanceManager(s)
+	im.launch = fakePipeLaunch(s)
+	im.SetRecycle(RecycleOptions{MaxNavigations: 2})
+	defer closeInstanceManager(t, im)
+	firstID, chromeInstance := im.addFakeInstance(t)
+
+	render := func(id int) {
+		if got := im.GetNewInstance("http://example.com/"); got != id {
+			t.Fatalf("GetNewInstance() = %v, want %v", got, id)
+		}
+		chromeInstance.NavigateToPage("http://example.com/")
+		if err := im.ReleaseInstance(id); err != nil {
+			t.Fatalf("ReleaseInstance(%v): %v", id, err)
+		}
+	}
+	render(firstID)
+	waitForInstances(t, im, 1, 1)
+	if chromeInstance.terminated() {
+		t.Fatalf("the instance was terminated, want it reset")
+	}
+	if _, err := im.GetInstance(firstID); err == nil {
+		t.Errorf("the recycled instance kept its ID %v", firstID)
+	}
+	if calls := s.CallsTo("Target.createBrowserContext"); len(calls) != 1 {
+		t.Errorf("Target.createBrowserContext invoked %v times, want 1", len(calls))
+	}
+	if calls := s.CallsTo("Target.closeTarget"); len(calls) != 1 {
+		t.Errorf("Target.closeTarget invoked %v times, want 1 for the initial tab", len(calls))
+	}
+
+	// The second page is the last one.
+	render(firstID + 1)
+	waitForInstances(t, im, 0, 0)
+	if !chromeInstance.terminated() {
+		t.Errorf("the instance was not terminated after %v pages", chromeInstance.Navigations())
+	}
+}
+
+// Test that instances controlled through a page-level connection are not recycled.
+func TestRecycleNeedsBrowserConnection(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	im := newFakeInstanceManager(s)
+	im.SetRecycle(RecycleOptions{MaxNavigations: 10})
+	id, chromeInstance := im.addFakeInstance(t)
+	im.GetNewInstance("http://example.com/")
+
+	if err := im.ReleaseInstance(id); err != nil {
+		t.Fatalf("ReleaseInstance(%v): %v", id, err)
+	}
+	if !chromeInstance.terminated() {
+		t.Errorf("the instance was not terminated")
+	}
+	if _, total := im.countInstances(); total != 0 {
+		t.Errorf("%v instances left after release", total)
+	}
+}
+
+// Test that instances are tabs sharing Chrome processes when TabsPerProcess is set.
+func TestTabsPerProcess(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	im := newFakeInstanceManager(s)
+	launches := 0
+	launch := fakePipeLaunch(s)
+	im.launch = func() (*Instance, error) {
+		launches++
+		return launch()
+	}
+	im.SetPool(PoolOptions{TabsPerProcess: 2})
+	defer closeInstanceManager(t, im)
+
+	var tabs []*Instance
+	for i := 0; i < 3; i++ {
+		_, tab := im.addFakeInstance(t)
+		tabs = append(tabs, tab)
+	}
+	if launches != 2 {
+		t.Errorf("started %v Chrome processes for 3 tabs, want 2", launches)
+	}
+	if tabs[0].parent != tabs[1].parent || tabs[1].parent == tabs[2].parent {
+		t.Errorf("the first two tabs should share a Chrome process, and the third one have its own")
+	}
+
+	// The process of the released tab has room for another one.
+	id := im.GetNewInstance("http://example.com/")
+	im.ReleaseInstance(id)
+	im.addFakeInstance(t)
+	if launches != 2 {
+		t.Errorf("started %v Chrome processes, want the tab opened in a running one", launches)
+	}
+}
+
+// Test that idle instances that die are evicted, and replaced.
+func TestEvictDeadInstances(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	im := newFakeInstanceManager(s)
+	im.SetPool(PoolOptions{MinIdle: 1, MaxIdle: 1})
+	defer closeInstanceManager(t, im)
+	go im.maintainPool()
+	waitForInstances(t, im, 1, 1)
+	im.instancesMutex.Lock()
+	deadID := im.idle[0].id
+	chromeInstance := im.instances[deadID]
+	im.instancesMutex.Unlock()
+
+	chromeInstance.die(errors.New("the tab crashed"))
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		im.instancesMutex.Lock()
+		replaced := len(im.idle) == 1 && im.idle[0].id != deadID
+		im.instancesMutex.Unlock()
+		if replaced {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("the dead instance was not replaced")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if _, err := im.GetInstance(deadID); err == nil {
+		t.Errorf("the dead instance %v was not evicted", deadID)
+	}
+}
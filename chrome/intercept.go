@@ -0,0 +1,348 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+
+	"streaming_hdp/devtools"
+)
+
+// InterceptAction is what an InterceptRule does to the requests it matches.
+type InterceptAction string
+
+const (
+	// InterceptAllow lets the requests through, e.g. to exempt them from the rules and lists after.
+	InterceptAllow InterceptAction = "allow"
+	// InterceptBlock fails the requests, as if blocked by an extension.
+	InterceptBlock InterceptAction = "block"
+	// InterceptRewrite sends the requests to InterceptRule.RewriteURL instead.
+	InterceptRewrite InterceptAction = "rewrite"
+	// InterceptMock answers the requests with InterceptRule.Status, ContentType and Body, without fetching them.
+	InterceptMock InterceptAction = "mock"
+)
+
+// InterceptRule matches requests of pages, and selects what happens to them.
+type InterceptRule struct {
+	// The URLs matched, where * matches any characters, e.g. "*://*.example.com/*.mp4". Empty matches all.
+	URL           string          `json:"url,omitempty"`
+	ResourceTypes []string        `json:"resourceTypes,omitempty"` // If set, the only resource types matched, e.g. "Image" or "Script".
+	ThirdParty    bool            `json:"thirdParty,omitempty"`    // Whether to only match requests to other sites than the page.
+	Action        InterceptAction `json:"action"`
+	RewriteURL    string          `json:"rewriteURL,omitempty"`  // For InterceptRewrite, the URL fetched instead.
+	Status        int             `json:"status,omitempty"`      // For InterceptMock, the status code of the response. Defaults to 200.
+	ContentType   string          `json:"contentType,omitempty"` // For InterceptMock, the Content-Type of the response.
+	Body          string          `json:"body,omitempty"`        // For InterceptMock, the body of the response.
+	pattern       *regexp.Regexp
+	types         map[string]bool
+}
+
+// String returns a description of the rule for the logs, e.g. "block *.mp4 types=Media third-party".
+func (r *InterceptRule) String() string {
+	parts := []string{string(r.Action)}
+	if r.URL != "" {
+		parts = append(parts, r.URL)
+	}
+	if len(r.ResourceTypes) > 0 {
+		parts = append(parts, "types="+strings.Join(r.ResourceTypes, ","))
+	}
+	if r.ThirdParty {
+		parts = append(parts, "third-party")
+	}
+	switch r.Action {
+	case InterceptRewrite:
+		parts = append(parts, "to="+r.RewriteURL)
+	case InterceptMock:
+		parts = append(parts, fmt.Sprintf("status=%d", r.Status))
+	}
+	return strings.Join(parts, " ")
+}
+
+// compile validates the rule, and prepares it for matching requests.
+func (r *InterceptRule) compile() error {
+	switch r.Action {
+	case InterceptAllow, InterceptBlock:
+	case InterceptRewrite:
+		if r.RewriteURL == "" {
+			return fmt.Errorf("the rule %q rewrites requests without a rewriteURL", r)
+		}
+	case InterceptMock:
+		if r.Status == 0 {
+			r.Status = 200
+		}
+		if r.Status < 100 || r.Status > 599 {
+			return fmt.Errorf("the rule %q mocks responses with an invalid status", r)
+		}
+	default:
+		return fmt.Errorf("unknown action %q, want one of allow, block, rewrite or mock", r.Action)
+	}
+	if r.URL != "" {
+		parts := strings.Split(r.URL, "*")
+		for i, part := range parts {
+			parts[i] = regexp.QuoteMeta(part)
+		}
+		r.pattern = regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+	}
+	if len(r.ResourceTypes) > 0 {
+		r.types = make(map[string]bool)
+		for _, resourceType := range r.ResourceTypes {
+			r.types[strings.ToLower(resourceType)] = true
+		}
+	}
+	return nil
+}
+
+// matches returns whether the rule matches the request.
+func (r *InterceptRule) matches(req interceptedRequest) bool {
+	if r.ThirdParty && !req.thirdParty {
+		return false
+	}
+	if r.types != nil && !r.types[strings.ToLower(req.resourceType)] {
+		return false
+	}
+	return r.pattern == nil || r.pattern.MatchString(req.url)
+}
+
+// LoadInterceptRules reads a JSON array of rules from the file at path, e.g.
+// [{"url": "*://ads.example.com/*", "action": "block"}, {"url": "*/analytics.js", "action": "mock"}].
+func LoadInterceptRules(path string) ([]InterceptRule, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	decoder := json.NewDecoder(f)
+	decoder.DisallowUnknownFields()
+	var rules []InterceptRule
+	if err := decoder.Decode(&rules); err != nil {
+		return nil, fmt.Errorf("failed to read the rules of %v: %v", path, err)
+	}
+	return rules, nil
+}
+
+// Interceptor selects what happens to the requests of pages: the first of its rules matching a request
+// applies, or if none does, the request is blocked if one of its filter lists matches it. The others
+// are let through. The main document of pages is never intercepted.
+type Interceptor struct {
+	rules []InterceptRule
+	lists []*FilterList
+}
+
+// NewInterceptor returns an Interceptor applying the rules, then the filter lists.
+func NewInterceptor(rules []InterceptRule, lists ...*FilterList) (*Interceptor, error) {
+	compiled := make([]InterceptRule, len(rules))
+	copy(compiled, rules)
+	for i := range compiled {
+		if err := compiled[i].compile(); err != nil {
+			return nil, err
+		}
+	}
+	return &Interceptor{rules: compiled, lists: lists}, nil
+}
+
+// decide returns the rule applying to the request, or nil to let it through, and a description
+// of why for the logs.
+func (i *Interceptor) decide(req interceptedRequest) (*InterceptRule, string) {
+	for j := range i.rules {
+		if rule := &i.rules[j]; rule.matches(req) {
+			return rule, rule.String()
+		}
+	}
+	for _, list := range i.lists {
+		if f := list.match(req); f != nil {
+			return &InterceptRule{Action: InterceptBlock}, list.Name + ": " + f.text
+		}
+	}
+	return nil, ""
+}
+
+// interceptedRequest is a request of a page, as matched by rules and filters.
+type interceptedRequest struct {
+	url          string
+	lowerURL     string
+	host         string
+	resourceType string // The resource type of Fetch.requestPaused, e.g. "Image".
+	pageHost     string
+	thirdParty   bool // Whether the request is to another site than the page.
+}
+
+// newInterceptedRequest returns the request of the resource type to rawURL, made by the page.
+func newInterceptedRequest(rawURL, resourceType, page string) interceptedRequest {
+	req := interceptedRequest{url: rawURL, lowerURL: strings.ToLower(rawURL), resourceType: resourceType}
+	if u, err := url.Parse(rawURL); err == nil {
+		req.host = strings.ToLower(u.Hostname())
+	}
+	if u, err := url.Parse(page); err == nil {
+		req.pageHost = strings.ToLower(u.Hostname())
+	}
+	req.thirdParty = req.host != "" && req.pageHost != "" && site(req.host) != site(req.pageHost)
+	return req
+}
+
+// site returns the registrable domain of host, e.g. "example.co.uk" for "www.example.co.uk", from the
+// public suffix list. Hosts without one, e.g. IP addresses or "localhost", are their own site.
+func site(host string) string {
+	if net.ParseIP(host) != nil {
+		return host
+	}
+	domain, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		return host
+	}
+	return domain
+}
+
+// Interception is a request of a page blocked, rewritten or mocked by the Interceptor of the launch options.
+type Interception struct {
+	URL          string
+	ResourceType string
+	Action       InterceptAction
+	Rule         string // The rule or the filter matching the request.
+}
+
+// Interceptions returns the requests of the current page blocked, rewritten or mocked so far.
+func (c *Instance) Interceptions() []Interception {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Interception(nil), c.page.interceptions...)
+}
+
+// startInterception starts intercepting the requests of the page about to be navigated to, to apply
+// the Interceptor of the launch options, if any, and send mainHeaders with the request of the page.
+func (c *Instance) startInterception(page string, mainHeaders http.Header) {
+	c.mu.Lock()
+	target := c.target
+	c.mu.Unlock()
+	if target == nil {
+		return
+	}
+	// Subscribe before stopping the previous interception, not to miss requests.
+	paused, cancel := target.Subscribe("Fetch.requestPaused")
+	c.mu.Lock()
+	c.stopIntercepting()
+	stop := make(chan struct{})
+	c.page.stopIntercept = stop
+	c.page.interceptions = nil
+	c.mu.Unlock()
+
+	ctx, cancelCall := context.WithTimeout(context.Background(), methodTimeout)
+	defer cancelCall()
+	frameTree, err := target.Call(ctx, "Page.getFrameTree", devtools.Params{})
+	if err != nil {
+		fmt.Printf("failed to get the main frame of the tab: %v\n", err)
+	}
+	mainFrame, _ := frameTree.String("frameTree.frame.id")
+	pattern := devtools.Params{"urlPattern": "*", "requestStage": "Request"}
+	if c.options.Interceptor == nil {
+		pattern["resourceType"] = "Document"
+	}
+	target.InvokeMethod("Fetch.enable", devtools.Params{"patterns": []devtools.Params{pattern}})
+	go c.intercept(target, c.options.Interceptor, page, mainFrame, mainHeaders, paused, cancel, stop)
+}
+
+// disableInterception stops intercepting requests, e.g. for a page whose requests need not be.
+func (c *Instance) disableInterception() {
+	c.mu.Lock()
+	intercepting := c.page.stopIntercept != nil
+	c.stopIntercepting()
+	target := c.target
+	c.mu.Unlock()
+	if intercepting && target != nil {
+		target.InvokeMethod("Fetch.disable", devtools.Params{})
+	}
+}
+
+// intercept resumes the requests paused by Fetch as decided by the interceptor, if any, until stop is
+// closed. The request of the page, to the host of page, is sent with mainHeaders.
+func (c *Instance) intercept(target devtools.Target, interceptor *Interceptor, page, mainFrame string, mainHeaders http.Header, paused <-chan devtools.EventMessage, cancel func(), stop <-chan struct{}) {
+	defer cancel()
+	for {
+		var event devtools.EventMessage
+		var ok bool
+		select {
+		case <-stop:
+			return
+		case <-c.Dead():
+			return
+		case event, ok = <-paused:
+			if !ok {
+				return
+			}
+		}
+		id, _ := event.Params.String("requestId")
+		rawURL, _ := event.Params.String("request.url")
+		resourceType, _ := event.Params.String("resourceType")
+		frame, _ := event.Params.String("frameId")
+		if resourceType == "Document" && frame == mainFrame {
+			params := devtools.Params{"requestId": id}
+			// Redirects to other hosts are not sent the headers.
+			if mainHeaders != nil && sameHost(rawURL, page) {
+				request, _ := event.Params["request"].(map[string]interface{})
+				params["headers"] = withHeaders(request, mainHeaders)
+			}
+			target.InvokeMethod("Fetch.continueRequest", params)
+			continue
+		}
+		if interceptor == nil {
+			target.InvokeMethod("Fetch.continueRequest", devtools.Params{"requestId": id})
+			continue
+		}
+
+		rule, reason := interceptor.decide(newInterceptedRequest(rawURL, resourceType, page))
+		if rule == nil || rule.Action == InterceptAllow {
+			target.InvokeMethod("Fetch.continueRequest", devtools.Params{"requestId": id})
+			continue
+		}
+		switch rule.Action {
+		case InterceptBlock:
+			target.InvokeMethod("Fetch.failRequest", devtools.Params{"requestId": id, "errorReason": "BlockedByClient"})
+		case InterceptRewrite:
+			target.InvokeMethod("Fetch.continueRequest", devtools.Params{"requestId": id, "url": rule.RewriteURL})
+		case InterceptMock:
+			headers := []devtools.Params{}
+			if rule.ContentType != "" {
+				headers = append(headers, devtools.Params{"name": "Content-Type", "value": rule.ContentType})
+			}
+			target.InvokeMethod("Fetch.fulfillRequest", devtools.Params{
+				"requestId":       id,
+				"responseCode":    rule.Status,
+				"responseHeaders": headers,
+				"body":            base64.StdEncoding.EncodeToString([]byte(rule.Body)),
+			})
+		}
+		c.mu.Lock()
+		c.page.interceptions = append(c.page.interceptions, Interception{URL: rawURL, ResourceType: resourceType, Action: rule.Action, Rule: reason})
+		c.mu.Unlock()
+	}
+}
+
+// stopIntercepting stops intercepting the requests of the current page. c.mu must be held.
+func (c *Instance) stopIntercepting() {
+	if c.page.stopIntercept != nil {
+		close(c.page.stopIntercept)
+		c.page.stopIntercept = nil
+	}
+}
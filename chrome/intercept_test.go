@@ -0,0 +1,211 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"streaming_hdp/devtools"
+	"streaming_hdp/devtools/cdptest"
+)
+
+// waitForCalls waits for the method to be invoked n times on the fake Chrome, and returns the calls.
+func waitForCalls(t *testing.T, s *cdptest.Server, method string, n int) []cdptest.Call {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		calls := s.CallsTo(method)
+		if len(calls) >= n {
+			return calls
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%v invoked %v times, want %v", method, len(calls), n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestInterceptorDecide(t *testing.T) {
+	list, err := ParseFilterList(strings.NewReader("||ads.example.com^\n"))
+	if err != nil {
+		t.Fatalf("ParseFilterList: %v", err)
+	}
+	list.Name = "easylist.txt"
+	interceptor, err := NewInterceptor([]InterceptRule{
+		{URL: "*://ads.example.com/house/*", Action: InterceptAllow},
+		{ResourceTypes: []string{"media", "Font"}, Action: InterceptBlock},
+		{URL: "*/analytics.js", Action: InterceptMock, Body: "//"},
+		{URL: "*", ThirdParty: true, ResourceTypes: []string{"Image"}, Action: InterceptRewrite, RewriteURL: "https://example.com/1x1.gif"},
+	}, list)
+	if err != nil {
+		t.Fatalf("NewInterceptor: %v", err)
+	}
+
+	testCases := []struct {
+		url          string
+		resourceType string
+		want         InterceptAction
+		wantReason   string
+	}{
+		{"https://ads.example.com/house/ad.js", "Script", InterceptAllow, "allow *://ads.example.com/house/*"},
+		{"https://ads.example.com/ad.js", "Script", InterceptBlock, "easylist.txt: ||ads.example.com^"},
+		{"https://example.com/clip.mp4", "Media", InterceptBlock, "block types=media,Font"},
+		{"https://cdn.example.net/analytics.js", "Script", InterceptMock, "mock */analytics.js status=200"},
+		{"https://cdn.example.net/photo.jpg", "Image", InterceptRewrite, "rewrite * types=Image third-party to=https://example.com/1x1.gif"},
+		{"https://www.example.com/photo.jpg", "Image", "", ""},
+	}
+	for _, tc := range testCases {
+		rule, reason := interceptor.decide(newInterceptedRequest(tc.url, tc.resourceType, "https://www.example.com/"))
+		var got InterceptAction
+		if rule != nil {
+			got = rule.Action
+		}
+		if got != tc.want || reason != tc.wantReason {
+			t.Errorf("decide(%v %v) = %q, %q, want %q, %q", tc.resourceType, tc.url, got, reason, tc.want, tc.wantReason)
+		}
+	}
+
+	for _, rule := range []InterceptRule{
+		{Action: "redirect"},
+		{Action: InterceptRewrite},
+		{Action: InterceptMock, Status: 1000},
+	} {
+		if _, err := NewInterceptor([]InterceptRule{rule}); err == nil {
+			t.Errorf("NewInterceptor(%+v) succeeded, want an error", rule)
+		}
+	}
+}
+
+func TestSite(t *testing.T) {
+	for host, want := range map[string]string{
+		"example.com":        "example.com",
+		"www.example.com":    "example.com",
+		"a.b.example.com":    "example.com",
+		"www.example.co.uk":  "example.co.uk",
+		"static.example.io":  "example.io",
+		"localhost":          "localhost",
+		"192.168.1.10":       "192.168.1.10",
+		"cdn.example.com.au": "example.com.au",
+		"www.ibm.de":         "ibm.de",
+		"cdn.ibm.de":         "ibm.de",
+	} {
+		if got := site(host); got != want {
+			t.Errorf("site(%v) = %v, want %v", host, got, want)
+		}
+	}
+}
+
+func TestLoadInterceptRules(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rules")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "rules.json")
+	ioutil.WriteFile(path, []byte(`[{"url": "*.mp4", "action": "block"}, {"url": "*/a.js", "action": "mock", "status": 204}]`), 0644)
+	rules, err := LoadInterceptRules(path)
+	if err != nil {
+		t.Fatalf("LoadInterceptRules: %v", err)
+	}
+	want := []InterceptRule{{URL: "*.mp4", Action: InterceptBlock}, {URL: "*/a.js", Action: InterceptMock, Status: 204}}
+	if !reflect.DeepEqual(rules, want) {
+		t.Errorf("LoadInterceptRules() = %+v, want %+v", rules, want)
+	}
+
+	ioutil.WriteFile(path, []byte(`[{"pattern": "*.mp4", "action": "block"}]`), 0644)
+	if _, err := LoadInterceptRules(path); err == nil {
+		t.Errorf("LoadInterceptRules() of a rule with an unknown field succeeded, want an error")
+	}
+}
+
+func TestInterception(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.HandleResult("Page.getFrameTree", devtools.Params{"frameTree": devtools.Params{"frame": devtools.Params{"id": "main"}}})
+	interceptor, err := NewInterceptor([]InterceptRule{
+		{ResourceTypes: []string{"Document"}, Action: InterceptBlock},
+		{URL: "*.png", Action: InterceptBlock},
+		{URL: "*/old.css", Action: InterceptRewrite, RewriteURL: "http://example.com/new.css"},
+		{URL: "*/analytics.js", Action: InterceptMock, ContentType: "text/javascript", Body: "// mocked"},
+	})
+	if err != nil {
+		t.Fatalf("NewInterceptor: %v", err)
+	}
+	chromeInstance := connectToFakeChrome(t, s)
+	defer chromeInstance.DisconnectAndTerminate()
+	chromeInstance.options.Interceptor = interceptor
+	if _, err := chromeInstance.NavigateToPage("http://example.com/"); err != nil {
+		t.Fatalf("NavigateToPage: %v", err)
+	}
+	waitForCalls(t, s, "Page.navigate", 1)
+	if calls := s.CallsTo("Fetch.enable"); len(calls) != 1 {
+		t.Fatalf("Fetch.enable invoked %v times, want 1", len(calls))
+	}
+
+	paused := func(id, url, resourceType, frame string) devtools.EventMessage {
+		return devtools.EventMessage{Method: "Fetch.requestPaused", Params: devtools.Params{
+			"requestId":    id,
+			"request":      devtools.Params{"url": url},
+			"resourceType": resourceType,
+			"frameId":      frame,
+		}}
+	}
+	s.Emit(
+		paused("1", "http://example.com/", "Document", "main"),
+		paused("2", "http://example.com/logo.png", "Image", "main"),
+		paused("3", "http://example.com/old.css", "Stylesheet", "main"),
+		paused("4", "http://example.com/analytics.js", "Script", "main"),
+		paused("5", "http://example.com/app.js", "Script", "main"),
+		paused("6", "http://ads.example.net/", "Document", "iframe"),
+	)
+
+	continued := waitForCalls(t, s, "Fetch.continueRequest", 3)
+	var continuedIDs []string
+	for _, call := range continued {
+		id, _ := call.Params.String("requestId")
+		continuedIDs = append(continuedIDs, id)
+		if url, _ := call.Params.String("url"); id == "3" && url != "http://example.com/new.css" {
+			t.Errorf("the stylesheet was rewritten to %q, want http://example.com/new.css", url)
+		}
+	}
+	if want := []string{"1", "3", "5"}; !reflect.DeepEqual(continuedIDs, want) {
+		t.Errorf("continued requests %v, want %v", continuedIDs, want)
+	}
+	failed := waitForCalls(t, s, "Fetch.failRequest", 2)
+	if id, _ := failed[0].Params.String("requestId"); id != "2" {
+		t.Errorf("failed request %v, want 2", id)
+	}
+	fulfilled := waitForCalls(t, s, "Fetch.fulfillRequest", 1)
+	body, _ := fulfilled[0].Params.String("body")
+	if decoded, _ := base64.StdEncoding.DecodeString(body); string(decoded) != "// mocked" {
+		t.Errorf("the mocked body = %q, want %q", decoded, "// mocked")
+	}
+
+	interceptions := chromeInstance.Interceptions()
+	var actions []InterceptAction
+	for _, interception := range interceptions {
+		actions = append(actions, interception.Action)
+	}
+	if want := []InterceptAction{InterceptBlock, InterceptRewrite, InterceptMock, InterceptBlock}; !reflect.DeepEqual(actions, want) {
+		t.Errorf("Interceptions() = %+v, want the actions %v", interceptions, want)
+	}
+}
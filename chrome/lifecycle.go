@@ -0,0 +1,210 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// The default bounds of the instances handed out for a page.
+const (
+	defaultRequestTimeout = time.Minute
+	defaultIdleTimeout    = 25 * time.Second
+	defaultMaxLifetime    = time.Hour
+)
+
+// The reasons instances are terminated by their lifecycle, as returned by Instance.Err.
+var (
+	// ErrRequestTimeout is the error of the instances whose page took longer than LifecycleOptions.RequestTimeout,
+	// or than the deadline of the context of the request, to be rendered.
+	ErrRequestTimeout = errors.New("timed out waiting for the page")
+	// ErrIdleTimeout is the error of the instances that went longer than LifecycleOptions.IdleTimeout without activity.
+	ErrIdleTimeout = errors.New("the renderer was idle for too long")
+	// ErrMaxLifetime is the error of the instances used for longer than LifecycleOptions.MaxLifetime.
+	ErrMaxLifetime = errors.New("the Chrome instance reached its maximum lifetime")
+	// ErrClientGone is the error of the instances whose request was canceled, e.g. because the client went away.
+	ErrClientGone = errors.New("the client went away")
+
+	// errPageDone ends the lifecycle of a page without terminating the instance, e.g. when it is reset.
+	errPageDone = errors.New("the page is done")
+)
+
+// LifecycleOptions bounds how long an instance handed out for a page is used before it is terminated.
+// Zero values disable the bounds.
+type LifecycleOptions struct {
+	RequestTimeout time.Duration // How long the page may take to be rendered, since the instance was handed out.
+	IdleTimeout    time.Duration // How long the instance may go without activity, see Instance.Touch.
+	MaxLifetime    time.Duration // How long the instance may be used since Chrome started, across pages when recycled.
+}
+
+// DefaultLifecycleOptions returns the lifecycle of the instances handed out by a new InstanceManager.
+func DefaultLifecycleOptions() LifecycleOptions {
+	return LifecycleOptions{
+		RequestTimeout: defaultRequestTimeout,
+		IdleTimeout:    defaultIdleTimeout,
+		MaxLifetime:    defaultMaxLifetime,
+	}
+}
+
+// Begin starts the lifecycle of the page the instance is handed out for: the instance is terminated
+// once ctx is done, or it exceeds the bounds of opts, and Err returns why. The lifecycle of the
+// previous page, if any, ends.
+func (c *Instance) Begin(ctx context.Context, opts LifecycleOptions) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.endLifecycle(errPageDone)
+	if c.startedAt.IsZero() {
+		c.startedAt = time.Now()
+	}
+	var cancels []context.CancelFunc
+	if opts.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, opts.RequestTimeout, ErrRequestTimeout)
+		cancels = append(cancels, cancel)
+	}
+	if opts.MaxLifetime > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadlineCause(ctx, c.startedAt.Add(opts.MaxLifetime), ErrMaxLifetime)
+		cancels = append(cancels, cancel)
+	}
+	ctx, endPage := context.WithCancelCause(ctx)
+	c.page.ctx = ctx
+	c.page.endPage = func(cause error) {
+		endPage(cause)
+		for _, cancel := range cancels {
+			cancel()
+		}
+	}
+	c.lastActivity = time.Now()
+	go c.watchLifecycle(ctx, opts.IdleTimeout)
+}
+
+// Bind terminates the instance when ctx is done, e.g. with the context of the request of a client
+// going away, until the lifecycle of the current page ends.
+func (c *Instance) Bind(ctx context.Context) {
+	page := c.Context()
+	go func() {
+		select {
+		case <-page.Done():
+		case <-c.Dead():
+		case <-ctx.Done():
+			select {
+			case <-page.Done():
+				// The page ended first.
+			default:
+				c.die(lifecycleError(ctx))
+			}
+		}
+	}()
+}
+
+// Context returns the context of the page the instance is handed out for: it is done once the
+// instance is terminated or moves on to another page. Returns a context done once the instance is
+// terminated if no page began.
+func (c *Instance) Context() context.Context {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.page.ctx == nil {
+		ctx, cancel := context.WithCancelCause(context.Background())
+		c.page.ctx = ctx
+		c.page.endPage = cancel
+		go func() {
+			select {
+			case <-ctx.Done():
+			case <-c.Dead():
+				cancel(c.Err())
+			}
+		}()
+	}
+	return c.page.ctx
+}
+
+// Touch records activity on the instance, postponing its idle timeout. Returns false if the instance
+// was already terminated.
+func (c *Instance) Touch() bool {
+	c.mu.Lock()
+	c.lastActivity = time.Now()
+	c.mu.Unlock()
+	return c.Err() == nil
+}
+
+// Age returns how long ago the instance began its first page.
+func (c *Instance) Age() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.startedAt.IsZero() {
+		return 0
+	}
+	return time.Since(c.startedAt)
+}
+
+// finishPage ends the lifecycle of the current page, without terminating the instance.
+func (c *Instance) finishPage() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.endLifecycle(errPageDone)
+}
+
+// endLifecycle ends the lifecycle of the current page with cause. c.mu must be held.
+func (c *Instance) endLifecycle(cause error) {
+	if c.page.endPage != nil {
+		c.page.endPage(cause)
+		c.page.endPage = nil
+	}
+}
+
+// watchLifecycle terminates the instance when the context of its page is done, or it is idle for
+// longer than idleTimeout, until the page ends.
+func (c *Instance) watchLifecycle(ctx context.Context, idleTimeout time.Duration) {
+	var idle <-chan time.Time
+	var timer *time.Timer
+	if idleTimeout > 0 {
+		timer = time.NewTimer(idleTimeout)
+		defer timer.Stop()
+		idle = timer.C
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			if err := lifecycleError(ctx); err != errPageDone {
+				c.die(err)
+			}
+			return
+		case <-idle:
+			c.mu.Lock()
+			left := idleTimeout - time.Since(c.lastActivity)
+			c.mu.Unlock()
+			if left <= 0 {
+				c.die(ErrIdleTimeout)
+				return
+			}
+			timer.Reset(left)
+		}
+	}
+}
+
+// lifecycleError returns why the context of a page is done.
+func lifecycleError(ctx context.Context) error {
+	switch err := context.Cause(ctx); err {
+	case context.Canceled:
+		return ErrClientGone
+	case context.DeadlineExceeded:
+		return ErrRequestTimeout
+	default:
+		return err
+	}
+}
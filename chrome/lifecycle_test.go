@@ -0,0 +1,129 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"streaming_hdp/devtools/cdptest"
+)
+
+// waitForReason waits until the instance terminates, and checks why.
+func waitForReason(t *testing.T, chromeInstance *Instance, want error) {
+	select {
+	case <-chromeInstance.Dead():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("the instance was not terminated, want %v", want)
+	}
+	if err := chromeInstance.Err(); err != want {
+		t.Errorf("Err() = %v, want %v", err, want)
+	}
+}
+
+func TestLifecycle(t *testing.T) {
+	testCases := []struct {
+		name string
+		opts LifecycleOptions
+		want error
+	}{
+		{"request_timeout", LifecycleOptions{RequestTimeout: 10 * time.Millisecond, IdleTimeout: time.Minute}, ErrRequestTimeout},
+		{"idle_timeout", LifecycleOptions{RequestTimeout: time.Minute, IdleTimeout: 10 * time.Millisecond}, ErrIdleTimeout},
+		{"max_lifetime", LifecycleOptions{RequestTimeout: time.Minute, MaxLifetime: 10 * time.Millisecond}, ErrMaxLifetime},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := cdptest.NewServer()
+			defer s.Close()
+			chromeInstance := newFakeInstance(t, s)
+			chromeInstance.Begin(context.Background(), tc.opts)
+			ctx := chromeInstance.Context()
+			waitForReason(t, chromeInstance, tc.want)
+			<-ctx.Done()
+			if cause := context.Cause(ctx); cause != tc.want {
+				t.Errorf("context.Cause(Context()) = %v, want %v", cause, tc.want)
+			}
+		})
+	}
+}
+
+func TestTouchPostponesIdleTimeout(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	chromeInstance := newFakeInstance(t, s)
+	chromeInstance.Begin(context.Background(), LifecycleOptions{IdleTimeout: 50 * time.Millisecond})
+	for i := 0; i < 10; i++ {
+		if !chromeInstance.Touch() {
+			t.Fatalf("the active instance was terminated: %v", chromeInstance.Err())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	waitForReason(t, chromeInstance, ErrIdleTimeout)
+	if chromeInstance.Touch() {
+		t.Errorf("Touch() of a terminated instance = true")
+	}
+}
+
+func TestBind(t *testing.T) {
+	testCases := []struct {
+		name   string
+		cancel bool // Whether the client cancels its request, instead of its deadline expiring.
+		want   error
+	}{
+		{"client_gone", true, ErrClientGone},
+		{"client_deadline", false, ErrRequestTimeout},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := cdptest.NewServer()
+			defer s.Close()
+			chromeInstance := newFakeInstance(t, s)
+			chromeInstance.Begin(context.Background(), DefaultLifecycleOptions())
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			chromeInstance.Bind(ctx)
+			if tc.cancel {
+				cancel()
+			}
+			waitForReason(t, chromeInstance, tc.want)
+		})
+	}
+}
+
+func TestFinishPage(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	chromeInstance := newFakeInstance(t, s)
+	defer chromeInstance.DisconnectAndTerminate()
+	chromeInstance.Begin(context.Background(), LifecycleOptions{RequestTimeout: 20 * time.Millisecond})
+	ctx, cancel := context.WithCancel(context.Background())
+	chromeInstance.Bind(ctx)
+	page := chromeInstance.Context()
+
+	// Neither the client going away nor the request timeout terminate the instance once its page is done.
+	chromeInstance.finishPage()
+	cancel()
+	<-page.Done()
+	time.Sleep(40 * time.Millisecond)
+	if err := chromeInstance.Err(); err != nil {
+		t.Fatalf("the instance was terminated after its page: %v", err)
+	}
+
+	// The next page has its own lifecycle.
+	chromeInstance.Begin(context.Background(), LifecycleOptions{RequestTimeout: 10 * time.Millisecond})
+	waitForReason(t, chromeInstance, ErrRequestTimeout)
+}
@@ -0,0 +1,281 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"streaming_hdp/devtools"
+)
+
+const (
+	// DefaultBudgetPollInterval is how often the resource usage of a page is polled, if Budget.PollInterval is not set.
+	DefaultBudgetPollInterval = 250 * time.Millisecond
+	// The period of cpu.max, in microseconds.
+	cgroupCPUPeriod = 100000
+	// How long to wait for the processes of a cgroup to exit before removing it.
+	cgroupRemoveTimeout = 5 * time.Second
+)
+
+// ErrBudgetExceeded is the error of the instances aborted because their page used more resources than its Budget.
+var ErrBudgetExceeded = errors.New("the page exceeded its resource budget")
+
+// ResourceLimits caps the resources of a Chrome process and all the processes it starts, e.g. its renderers.
+// The zero value sets no limit.
+type ResourceLimits struct {
+	// If set, each Chrome instance runs in its own cgroup v2, created in this directory, e.g.
+	// "/sys/fs/cgroup/hdp.slice". The directory must be delegated to the user running the proxy.
+	// The cgroup limits below require it.
+	CgroupParent string
+	MemoryMax    int64   // The memory.max of the cgroup, in bytes. Chrome is killed by the kernel above it.
+	CPUMax       float64 // The cpu.max of the cgroup, in CPUs, e.g. 0.5 for half a CPU.
+	PidsMax      int     // The pids.max of the cgroup, i.e. the maximum number of processes and threads.
+
+	// The rlimits of the Chrome process, inherited by the processes it starts. Set as soon as Chrome
+	// has started.
+	MaxOpenFiles uint64 // The RLIMIT_NOFILE of Chrome.
+	MaxCPUTime   uint64 // The RLIMIT_CPU of Chrome, in seconds of CPU time of each process.
+}
+
+// Budget caps the resources used by each page rendered by a Chrome instance: the instance rendering a
+// page above its budget is terminated, and its Err is ErrBudgetExceeded. The zero value sets no limit.
+type Budget struct {
+	// The CPU time the page may use. For instances owning their Chrome process, this is the CPU time
+	// of all the processes of Chrome, from SystemInfo.getProcessInfo; for the others, the duration
+	// of the tasks of the page, from Performance.getMetrics.
+	CPU          time.Duration
+	Memory       int64         // The size of the JavaScript heap the page may use, in bytes.
+	PollInterval time.Duration // How often the resource usage is polled. Defaults to DefaultBudgetPollInterval.
+}
+
+// enabled returns whether the budget limits anything.
+func (b Budget) enabled() bool {
+	return b.CPU > 0 || b.Memory > 0
+}
+
+// createCgroup creates a cgroup for a Chrome instance in parent, with the limits.
+func createCgroup(parent string, limits ResourceLimits) (string, error) {
+	var controllers []string
+	files := make(map[string]string)
+	if limits.CPUMax > 0 {
+		controllers = append(controllers, "+cpu")
+		files["cpu.max"] = fmt.Sprintf("%d %d", int64(limits.CPUMax*cgroupCPUPeriod), cgroupCPUPeriod)
+	}
+	if limits.MemoryMax > 0 {
+		controllers = append(controllers, "+memory")
+		files["memory.max"] = strconv.FormatInt(limits.MemoryMax, 10)
+		// Don't let Chrome swap instead of hitting the limit.
+		files["memory.swap.max"] = "0"
+	}
+	if limits.PidsMax > 0 {
+		controllers = append(controllers, "+pids")
+		files["pids.max"] = strconv.Itoa(limits.PidsMax)
+	}
+	if len(controllers) > 0 {
+		// The controllers must be enabled in the parent for its children to have limits.
+		err := ioutil.WriteFile(filepath.Join(parent, "cgroup.subtree_control"), []byte(strings.Join(controllers, " ")), 0644)
+		if err != nil {
+			return "", fmt.Errorf("failed to enable the cgroup controllers %v: %v", controllers, err)
+		}
+	}
+	dir, err := ioutil.TempDir(parent, "chrome")
+	if err != nil {
+		return "", err
+	}
+	for name, value := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(value), 0644); err != nil {
+			if name == "memory.swap.max" && os.IsNotExist(err) {
+				// Swap accounting is disabled.
+				continue
+			}
+			os.Remove(dir)
+			return "", fmt.Errorf("failed to set %v of the cgroup: %v", name, err)
+		}
+	}
+	return dir, nil
+}
+
+// removeCgroup kills the processes left in the cgroup, e.g. the orphaned renderers of Chrome, and removes it.
+func removeCgroup(dir string) error {
+	// cgroup.kill requires Linux 5.14. Without it, the processes have to exit on their own.
+	if f, err := os.OpenFile(filepath.Join(dir, "cgroup.kill"), os.O_WRONLY, 0); err == nil {
+		f.WriteString("1")
+		f.Close()
+	}
+	deadline := time.Now().Add(cgroupRemoveTimeout)
+	for {
+		// Removing a cgroup fails with EBUSY while it has processes.
+		err := os.Remove(dir)
+		if err == nil || os.IsNotExist(err) {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return err
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// cgroupMemory returns the memory used by the processes of the cgroup, in bytes.
+func cgroupMemory(dir string) (int64, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, "memory.current"))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// resourceUsage is the resources used by a page, as counted against its Budget.
+type resourceUsage struct {
+	cpu  time.Duration // The CPU time used so far.
+	heap int64         // The current size of the JavaScript heap, in bytes.
+}
+
+// measureUsage returns the resources used by the page of the target. If browser is set, the CPU time is
+// the one of all the processes of Chrome.
+func measureUsage(target devtools.Target, browser *devtools.Connection) (resourceUsage, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), methodTimeout)
+	defer cancel()
+	result, err := target.Call(ctx, "Performance.getMetrics", devtools.Params{})
+	if err != nil {
+		return resourceUsage{}, err
+	}
+	var usage resourceUsage
+	metrics, _ := result["metrics"].([]interface{})
+	for _, m := range metrics {
+		metric, ok := m.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := devtools.Params(metric).String("name")
+		value, _ := devtools.Params(metric).Float("value")
+		switch name {
+		case "TaskDuration":
+			usage.cpu = time.Duration(value * float64(time.Second))
+		case "JSHeapUsedSize":
+			usage.heap = int64(value)
+		}
+	}
+	if browser == nil {
+		return usage, nil
+	}
+
+	result, err = browser.Call(ctx, "SystemInfo.getProcessInfo", devtools.Params{})
+	if err != nil {
+		return resourceUsage{}, err
+	}
+	usage.cpu = 0
+	processes, _ := result["processInfo"].([]interface{})
+	for _, p := range processes {
+		process, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		cpuTime, _ := devtools.Params(process).Float("cpuTime")
+		usage.cpu += time.Duration(cpuTime * float64(time.Second))
+	}
+	return usage, nil
+}
+
+// startBudget starts enforcing the budget of the launch options on the page about to be navigated to.
+func (c *Instance) startBudget() {
+	budget := c.options.Budget
+	c.mu.Lock()
+	target := c.target
+	if target == nil {
+		c.mu.Unlock()
+		return
+	}
+	var browser *devtools.Connection
+	if _, ok := target.(*devtools.Session); ok && c.parent == nil {
+		browser = c.devtoolsConn
+	}
+	c.stopEnforcingBudget()
+	stop := make(chan struct{})
+	c.page.stopBudget = stop
+	c.mu.Unlock()
+
+	target.InvokeMethod("Performance.enable", devtools.Params{})
+	var start *resourceUsage
+	if usage, err := measureUsage(target, browser); err == nil {
+		start = &usage
+	} else {
+		fmt.Printf("failed to measure the resources used by the page, counting its CPU time from the next measure: %v\n", err)
+	}
+	go c.enforceBudget(target, browser, budget, start, stop)
+}
+
+// enforceBudget polls the resources used by the page of the target since start, and terminates the
+// instance with ErrBudgetExceeded once they exceed the budget, until stop is closed. Without start, the
+// CPU time is counted from the first usage measured.
+func (c *Instance) enforceBudget(target devtools.Target, browser *devtools.Connection, budget Budget, start *resourceUsage, stop <-chan struct{}) {
+	interval := budget.PollInterval
+	if interval <= 0 {
+		interval = DefaultBudgetPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-c.Dead():
+			return
+		case <-ticker.C:
+		}
+		usage, err := measureUsage(target, browser)
+		if err != nil {
+			// The page may be busy, try again later.
+			continue
+		}
+		if start == nil {
+			start = &usage
+		}
+		var exceeded string
+		if cpu := usage.cpu - start.cpu; budget.CPU > 0 && cpu > budget.CPU {
+			exceeded = fmt.Sprintf("%v of CPU time, over its budget of %v", cpu, budget.CPU)
+		} else if budget.Memory > 0 && usage.heap > budget.Memory {
+			exceeded = fmt.Sprintf("%v bytes of JavaScript heap, over its budget of %v", usage.heap, budget.Memory)
+		}
+		if exceeded == "" {
+			continue
+		}
+		select {
+		case <-stop:
+			// The instance moved on to another page while measuring.
+			return
+		default:
+		}
+		fmt.Printf("chrome instance %p aborted its page, which used %v\n", c, exceeded)
+		c.die(ErrBudgetExceeded)
+		return
+	}
+}
+
+// stopEnforcingBudget stops enforcing the budget of the current page. c.mu must be held.
+func (c *Instance) stopEnforcingBudget() {
+	if c.page.stopBudget != nil {
+		close(c.page.stopBudget)
+		c.page.stopBudget = nil
+	}
+}
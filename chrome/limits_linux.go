@@ -0,0 +1,58 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package chrome
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+	"unsafe"
+)
+
+// placeInCgroup makes cmd start in the cgroup, before Chrome can start any process. The returned
+// file must be closed once cmd has started.
+func placeInCgroup(cmd *exec.Cmd, dir string) (*os.File, error) {
+	f, err := os.Open(dir)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.UseCgroupFD = true
+	cmd.SysProcAttr.CgroupFD = int(f.Fd())
+	return f, nil
+}
+
+// setRlimits sets the rlimits of the process with the pid.
+func setRlimits(pid int, limits ResourceLimits) error {
+	rlimits := map[int]uint64{
+		syscall.RLIMIT_NOFILE: limits.MaxOpenFiles,
+		syscall.RLIMIT_CPU:    limits.MaxCPUTime,
+	}
+	for resource, max := range rlimits {
+		if max == 0 {
+			continue
+		}
+		rlimit := syscall.Rlimit{Cur: max, Max: max}
+		_, _, errno := syscall.RawSyscall6(syscall.SYS_PRLIMIT64, uintptr(pid), uintptr(resource), uintptr(unsafe.Pointer(&rlimit)), 0, 0, 0)
+		if errno != 0 {
+			return errno
+		}
+	}
+	return nil
+}
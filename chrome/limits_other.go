@@ -0,0 +1,36 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !linux
+
+package chrome
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+)
+
+// placeInCgroup fails: cgroups are only supported on Linux.
+func placeInCgroup(cmd *exec.Cmd, dir string) (*os.File, error) {
+	return nil, errors.New("cgroups are only supported on Linux")
+}
+
+// setRlimits fails if any rlimit is set: rlimits are only supported on Linux.
+func setRlimits(pid int, limits ResourceLimits) error {
+	if limits.MaxOpenFiles > 0 || limits.MaxCPUTime > 0 {
+		return errors.New("rlimits are only supported on Linux")
+	}
+	return nil
+}
@@ -0,0 +1,257 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"context"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"streaming_hdp/devtools"
+	"streaming_hdp/devtools/cdptest"
+)
+
+func TestCreateCgroup(t *testing.T) {
+	// A plain directory stands in for a delegated cgroup.
+	parent := t.TempDir()
+	dir, err := createCgroup(parent, ResourceLimits{MemoryMax: 100 << 20, CPUMax: 0.5, PidsMax: 64})
+	if err != nil {
+		t.Fatalf("createCgroup: %v", err)
+	}
+	if filepath.Dir(dir) != parent {
+		t.Errorf("createCgroup created %v, want a directory in %v", dir, parent)
+	}
+	want := map[string]string{
+		filepath.Join(parent, "cgroup.subtree_control"): "+cpu +memory +pids",
+		filepath.Join(dir, "memory.max"):                "104857600",
+		filepath.Join(dir, "cpu.max"):                   "50000 100000",
+		filepath.Join(dir, "pids.max"):                  "64",
+	}
+	for path, value := range want {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Errorf("reading %v: %v", path, err)
+			continue
+		}
+		if string(data) != value {
+			t.Errorf("%v = %q, want %q", path, data, value)
+		}
+	}
+}
+
+func TestSetRlimits(t *testing.T) {
+	cmd := exec.Command("sleep", "60")
+	if err := cmd.Start(); err != nil {
+		t.Skipf("failed to start a process: %v", err)
+	}
+	defer cmd.Wait()
+	defer cmd.Process.Kill()
+
+	if err := setRlimits(cmd.Process.Pid, ResourceLimits{MaxOpenFiles: 64}); err != nil {
+		t.Fatalf("setRlimits: %v", err)
+	}
+	limits, err := ioutil.ReadFile("/proc/" + strconv.Itoa(cmd.Process.Pid) + "/limits")
+	if err != nil {
+		t.Skipf("/proc is not available: %v", err)
+	}
+	for _, line := range strings.Split(string(limits), "\n") {
+		if strings.HasPrefix(line, "Max open files") {
+			if fields := strings.Fields(line); len(fields) < 5 || fields[3] != "64" || fields[4] != "64" {
+				t.Errorf("limits of the process: %q, want 64 open files", line)
+			}
+			return
+		}
+	}
+	t.Errorf("no open files limit in %s", limits)
+}
+
+// usageScript scripts the resource usage reported by a fake Chrome.
+type usageScript struct {
+	mu      sync.Mutex
+	cpu     float64 // In seconds.
+	heap    float64 // In bytes.
+	cpuStep float64 // Added to cpu on each report, in seconds.
+}
+
+// set sets the usage reported from now on.
+func (u *usageScript) set(cpu, heap, cpuStep float64) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.cpu, u.heap, u.cpuStep = cpu, heap, cpuStep
+}
+
+// next returns the next usage reported.
+func (u *usageScript) next() (cpu, heap float64) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.cpu += u.cpuStep
+	return u.cpu, u.heap
+}
+
+// handleMetrics makes the fake Chrome report the usage, through Performance.getMetrics and SystemInfo.getProcessInfo.
+func handleMetrics(s *cdptest.Server, u *usageScript) {
+	s.Handle("Performance.getMetrics", func(call cdptest.Call) (devtools.Params, error) {
+		cpu, heap := u.next()
+		return devtools.Params{"metrics": []interface{}{
+			devtools.Params{"name": "TaskDuration", "value": cpu},
+			devtools.Params{"name": "JSHeapUsedSize", "value": heap},
+		}}, nil
+	})
+	s.Handle("SystemInfo.getProcessInfo", func(call cdptest.Call) (devtools.Params, error) {
+		cpu, _ := u.next()
+		// The browser process takes a share of the CPU time.
+		return devtools.Params{"processInfo": []interface{}{
+			devtools.Params{"type": "browser", "id": 1, "cpuTime": 0.1},
+			devtools.Params{"type": "renderer", "id": 2, "cpuTime": cpu},
+		}}, nil
+	})
+}
+
+func TestBudget(t *testing.T) {
+	testCases := []struct {
+		name         string
+		budget       Budget
+		cpu, cpuStep float64
+		heap         float64
+		wantExceeded bool
+	}{
+		{"within_budget", Budget{CPU: time.Second, Memory: 1 << 20}, 100, 0.01, 1 << 10, false},
+		{"cpu", Budget{CPU: time.Second}, 100, 0.5, 0, true},
+		{"memory", Budget{Memory: 1 << 20}, 0, 0, 2 << 20, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := cdptest.NewServer()
+			defer s.Close()
+			usage := &usageScript{}
+			usage.set(tc.cpu, tc.heap, tc.cpuStep)
+			handleMetrics(s, usage)
+			chromeInstance := connectToFakeChrome(t, s)
+			defer chromeInstance.DisconnectAndTerminate()
+			tc.budget.PollInterval = 10 * time.Millisecond
+			chromeInstance.options.Budget = tc.budget
+
+			if _, err := chromeInstance.NavigateToPage("http://example.com/"); err != nil {
+				t.Fatalf("NavigateToPage: %v", err)
+			}
+			if calls := s.CallsTo("Performance.enable"); len(calls) != 1 {
+				t.Errorf("Performance.enable invoked %v times, want 1", len(calls))
+			}
+			select {
+			case <-chromeInstance.Dead():
+				if !tc.wantExceeded {
+					t.Fatalf("the instance within its budget died: %v", chromeInstance.Err())
+				}
+				if err := chromeInstance.Err(); err != ErrBudgetExceeded {
+					t.Errorf("Err() = %v, want %v", err, ErrBudgetExceeded)
+				}
+			case <-time.After(200 * time.Millisecond):
+				if tc.wantExceeded {
+					t.Fatalf("the instance over its budget was not aborted")
+				}
+			}
+		})
+	}
+}
+
+// Test that the budget is enforced even if the resources used by the page could not be measured at first.
+func TestBudgetFirstMeasureFails(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	usage := &usageScript{}
+	usage.set(100, 0, 0.5)
+	var mu sync.Mutex
+	failed := false
+	s.Handle("Performance.getMetrics", func(call cdptest.Call) (devtools.Params, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if !failed {
+			failed = true
+			return nil, &devtools.ProtocolError{Code: -32000, Message: "Performance is busy"}
+		}
+		cpu, heap := usage.next()
+		return devtools.Params{"metrics": []interface{}{
+			devtools.Params{"name": "TaskDuration", "value": cpu},
+			devtools.Params{"name": "JSHeapUsedSize", "value": heap},
+		}}, nil
+	})
+	chromeInstance := connectToFakeChrome(t, s)
+	defer chromeInstance.DisconnectAndTerminate()
+	chromeInstance.options.Budget = Budget{CPU: time.Second, PollInterval: 10 * time.Millisecond}
+
+	if _, err := chromeInstance.NavigateToPage("http://example.com/"); err != nil {
+		t.Fatalf("NavigateToPage: %v", err)
+	}
+	select {
+	case <-chromeInstance.Dead():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("the instance over its budget was not aborted")
+	}
+	if err := chromeInstance.Err(); err != ErrBudgetExceeded {
+		t.Errorf("Err() = %v, want %v", err, ErrBudgetExceeded)
+	}
+}
+
+func TestBudgetOfChromeProcess(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	usage := &usageScript{}
+	usage.set(0, 0, 0.01)
+	handleMetrics(s, usage)
+	launch := fakePipeLaunch(s)
+	chromeInstance, err := launch()
+	if err != nil {
+		t.Fatalf("Failed to connect to the fake Chrome: %v", err)
+	}
+	defer chromeInstance.DisconnectAndTerminate()
+	chromeInstance.options.Budget = Budget{CPU: time.Second, PollInterval: 10 * time.Millisecond}
+
+	if _, err := chromeInstance.NavigateToPage("http://example.com/"); err != nil {
+		t.Fatalf("NavigateToPage: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := s.WaitForCall(ctx, "SystemInfo.getProcessInfo"); err != nil {
+		t.Fatalf("SystemInfo.getProcessInfo was not invoked: %v", err)
+	}
+
+	// The budget of the previous page stops applying once the instance is reset.
+	if err := chromeInstance.Reset(ctx); err != nil {
+		t.Fatalf("Reset: %v", err)
+	}
+	usage.set(100, 0, 1)
+	time.Sleep(50 * time.Millisecond)
+	if err := chromeInstance.Err(); err != nil {
+		t.Fatalf("the reset instance died: %v", err)
+	}
+
+	if _, err := chromeInstance.NavigateToPage("http://example.com/"); err != nil {
+		t.Fatalf("NavigateToPage: %v", err)
+	}
+	select {
+	case <-chromeInstance.Dead():
+	case <-ctx.Done():
+		t.Fatalf("the instance over its budget was not aborted")
+	}
+	if err := chromeInstance.Err(); err != ErrBudgetExceeded {
+		t.Errorf("Err() = %v, want %v", err, ErrBudgetExceeded)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// procStat is the part of /proc/<pid>/stat used to measure the memory of Chrome.
+type procStat struct {
+	pid  int
+	ppid int
+	rss  int64 // In pages.
+}
+
+// readProcStat parses /proc/<pid>/stat. See proc(5).
+func readProcStat(path string) (procStat, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return procStat{}, err
+	}
+	// The command name, in parentheses, may contain spaces.
+	end := strings.LastIndexByte(string(data), ')')
+	start := strings.IndexByte(string(data), ' ')
+	if start < 0 || end < 0 {
+		return procStat{}, errors.New("malformed " + path)
+	}
+	fields := strings.Fields(string(data[end+1:]))
+	// fields[0] is the state: the ppid is the 4th field of the line, and the rss the 24th.
+	if len(fields) < 22 {
+		return procStat{}, errors.New("malformed " + path)
+	}
+	pid, err := strconv.Atoi(string(data[:start]))
+	if err != nil {
+		return procStat{}, err
+	}
+	ppid, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return procStat{}, err
+	}
+	rss, err := strconv.ParseInt(fields[21], 10, 64)
+	if err != nil {
+		return procStat{}, err
+	}
+	return procStat{pid: pid, ppid: ppid, rss: rss}, nil
+}
+
+// processTreeRSS returns the resident memory of the process and its descendants, e.g. the renderers
+// and the GPU process of Chrome, in bytes. Only supported on Linux.
+func processTreeRSS(pid int) (int64, error) {
+	paths, err := filepath.Glob("/proc/[0-9]*/stat")
+	if err != nil {
+		return 0, err
+	}
+	if len(paths) == 0 {
+		return 0, errors.New("/proc is not available")
+	}
+	children := make(map[int][]procStat)
+	var root *procStat
+	for _, path := range paths {
+		stat, err := readProcStat(path)
+		if err != nil {
+			// The process exited since listing /proc.
+			continue
+		}
+		if stat.pid == pid {
+			root = &stat
+		}
+		children[stat.ppid] = append(children[stat.ppid], stat)
+	}
+	if root == nil {
+		return 0, errors.New("no process with pid " + strconv.Itoa(pid))
+	}
+
+	var pages int64
+	for queue := []procStat{*root}; len(queue) > 0; queue = queue[1:] {
+		pages += queue[0].rss
+		queue = append(queue, children[queue[0].pid]...)
+	}
+	return pages * int64(os.Getpagesize()), nil
+}
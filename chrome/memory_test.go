@@ -0,0 +1,57 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestReadProcStat(t *testing.T) {
+	dir, err := ioutil.TempDir("", "proc")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "stat")
+	// The command name of Chrome's renderers contains spaces and parentheses.
+	line := "4242 (chrome (renderer)) S 4200 4200 4200 0 -1 4194560 1 0 0 0 1 1 0 0 20 0 1 0 100 1000000 321 18446744073709551615\n"
+	if err := ioutil.WriteFile(path, []byte(line), 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	stat, err := readProcStat(path)
+	if err != nil {
+		t.Fatalf("readProcStat: %v", err)
+	}
+	if want := (procStat{pid: 4242, ppid: 4200, rss: 321}); stat != want {
+		t.Errorf("readProcStat() = %+v, want %+v", stat, want)
+	}
+}
+
+func TestProcessTreeRSS(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("/proc is only available on Linux")
+	}
+	rss, err := processTreeRSS(os.Getpid())
+	if err != nil {
+		t.Fatalf("processTreeRSS: %v", err)
+	}
+	if rss <= 0 {
+		t.Errorf("processTreeRSS() = %v, want the memory of the test", rss)
+	}
+}
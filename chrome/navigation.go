@@ -0,0 +1,233 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"streaming_hdp/devtools"
+)
+
+// abortedNavigationGrace is how long a navigation Chrome reports as failed waits for the events Chrome
+// sent before, which are delivered right away, to find out whether the page was received anyway.
+const abortedNavigationGrace = 100 * time.Millisecond
+
+// MainResponse is the response to the request of a page, from Network.responseReceived.
+type MainResponse struct {
+	URL           string          // The URL of the response, after redirects.
+	Status        int             // The status code of the response.
+	StatusText    string          // The status text of the response, e.g. "OK".
+	Header        http.Header     // The headers of the response.
+	MIMEType      string          // The MIME type of the response, as determined by Chrome.
+	SecurityState string          // The security state of the response, e.g. "secure" or "insecure".
+	Redirects     []*MainResponse // The redirects followed to get the response, in order.
+}
+
+// newMainResponse returns the response described by a Network.Response object.
+func newMainResponse(response map[string]interface{}) *MainResponse {
+	params := devtools.Params(response)
+	r := &MainResponse{Header: responseHeader(params["headers"])}
+	r.URL, _ = params.String("url")
+	r.Status, _ = params.Int("status")
+	r.StatusText, _ = params.String("statusText")
+	r.MIMEType, _ = params.String("mimeType")
+	r.SecurityState, _ = params.String("securityState")
+	return r
+}
+
+// responseHeader returns the headers of a Network.Headers object, where the values of the headers
+// present more than once, e.g. Set-Cookie, are separated by newlines.
+func responseHeader(headers interface{}) http.Header {
+	header := make(http.Header)
+	values, _ := headers.(map[string]interface{})
+	for name, value := range values {
+		s, _ := value.(string)
+		for _, v := range strings.Split(s, "\n") {
+			header.Add(name, v)
+		}
+	}
+	return header
+}
+
+// Navigation is the navigation of an instance to a page, see NavigateToPage.
+type Navigation struct {
+	URL      string // The URL navigated to.
+	done     chan struct{}
+	response *MainResponse
+	err      error
+}
+
+// Done returns a channel closed once the response to the request of the page is received, or the
+// navigation failed.
+func (n *Navigation) Done() <-chan struct{} {
+	return n.done
+}
+
+// Response waits for the response to the request of the page and returns it. Fails if the page could
+// not be fetched, e.g. because the connection was reset, if the instance died first, or if ctx is done.
+func (n *Navigation) Response(ctx context.Context) (*MainResponse, error) {
+	select {
+	case <-n.done:
+		return n.response, n.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// finish records the outcome of the navigation.
+func (n *Navigation) finish(response *MainResponse, err error) {
+	n.response = response
+	n.err = err
+	close(n.done)
+}
+
+// navigate navigates the target to the page with params. The returned Navigation gets the
+// response to the request of the page once Chrome receives it. Navigations Chrome aborts once the
+// response is received, e.g. to downloads such as PDFs, get that response.
+func (c *Instance) navigate(target devtools.Target, page string, params devtools.Params) (*Navigation, error) {
+	events, cancel := target.Subscribe("Network.*")
+	ctx := c.Context()
+	callCtx, cancelCall := context.WithTimeout(ctx, methodTimeout)
+	result, err := target.Call(callCtx, "Page.navigate", params)
+	cancelCall()
+	if ctx.Err() != nil {
+		err = lifecycleError(ctx)
+	} else if err != nil {
+		err = fmt.Errorf("failed to navigate to %v: %v", page, err)
+	}
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	nav := &Navigation{URL: page, done: make(chan struct{})}
+	if text, _ := result.String("errorText"); text != "" {
+		nav.await(ctx, result, events, cancel, fmt.Errorf("failed to navigate to %v: %v", page, text))
+		if nav.err != nil {
+			return nil, nav.err
+		}
+		return nav, nil
+	}
+	go nav.await(ctx, result, events, cancel, nil)
+	return nav, nil
+}
+
+// await records the response to the request of the page navigated to with result, from the
+// Network events of the target. If Chrome reported the navigation as failed, await only looks for
+// the response among the events received by then, and otherwise fails with failure.
+func (n *Navigation) await(ctx context.Context, result devtools.Params, events <-chan devtools.EventMessage, cancel func(), failure error) {
+	defer cancel()
+	var expired <-chan time.Time
+	if failure != nil {
+		timer := time.NewTimer(abortedNavigationGrace)
+		defer timer.Stop()
+		expired = timer.C
+	}
+	// The request of the page is the one of the loader of the navigation, or without one, the first
+	// request of a document in the frame.
+	requestID, _ := result.String("loaderId")
+	frameID, _ := result.String("frameId")
+	var redirects []*MainResponse
+	var response *MainResponse
+	var extraHeaders []devtools.Params
+	for {
+		var event devtools.EventMessage
+		var ok bool
+		select {
+		case <-ctx.Done():
+			n.finish(nil, lifecycleError(ctx))
+			return
+		case <-expired:
+			if response != nil {
+				// The raw headers never came.
+				n.finish(response, nil)
+			} else {
+				n.finish(nil, failure)
+			}
+			return
+		case event, ok = <-events:
+			if !ok {
+				// The subscription also ends when the instance is terminated.
+				if ctx.Err() != nil {
+					n.finish(nil, lifecycleError(ctx))
+				} else {
+					n.finish(nil, fmt.Errorf("lost the connection to DevTools while navigating to %v", n.URL))
+				}
+				return
+			}
+		}
+		id, _ := event.Params.String("requestId")
+		if requestID == "" && event.Method == "Network.requestWillBeSent" {
+			resourceType, _ := event.Params.String("type")
+			frame, _ := event.Params.String("frameId")
+			if resourceType == "Document" && (frameID == "" || frame == frameID) {
+				requestID = id
+			}
+		}
+		if id != requestID {
+			continue
+		}
+
+		switch event.Method {
+		case "Network.requestWillBeSent":
+			if redirect, ok := event.Params["redirectResponse"].(map[string]interface{}); ok {
+				redirects = append(redirects, newMainResponse(redirect))
+			}
+		case "Network.responseReceivedExtraInfo":
+			// The raw headers, including the cookies, of the redirects and the response.
+			extraHeaders = append(extraHeaders, event.Params)
+		case "Network.responseReceived":
+			r, _ := event.Params["response"].(map[string]interface{})
+			response = newMainResponse(r)
+			response.Redirects = redirects
+			if hasExtraInfo, _ := r["hasExtraInfo"].(bool); !hasExtraInfo {
+				n.finish(response, nil)
+				return
+			}
+		case "Network.loadingFinished":
+			if response != nil {
+				// The raw headers never came.
+				n.finish(response, nil)
+				return
+			}
+		case "Network.loadingFailed":
+			if failure != nil {
+				// Downloads are aborted once their response is received.
+				if response != nil {
+					n.finish(response, nil)
+				} else {
+					n.finish(nil, failure)
+				}
+				return
+			}
+			text, _ := event.Params.String("errorText")
+			n.finish(nil, fmt.Errorf("failed to load %v: %v", n.URL, text))
+			return
+		}
+		if response == nil {
+			continue
+		}
+		for _, extra := range extraHeaders {
+			if status, _ := extra.Int("statusCode"); status == response.Status {
+				response.Header = responseHeader(extra["headers"])
+				n.finish(response, nil)
+				return
+			}
+		}
+	}
+}
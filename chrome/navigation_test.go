@@ -0,0 +1,173 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"streaming_hdp/devtools"
+	"streaming_hdp/devtools/cdptest"
+)
+
+// navigationResponse navigates the instance connected to the fake Chrome to http://example.com/, lets
+// the fake Chrome emit the events, and returns the response to the request of the page.
+func navigationResponse(t *testing.T, s *cdptest.Server, events ...devtools.EventMessage) (*MainResponse, error) {
+	chromeInstance := connectToFakeChrome(t, s)
+	defer chromeInstance.DisconnectAndTerminate()
+	navigation, err := chromeInstance.NavigateToPage("http://example.com/")
+	if err != nil {
+		t.Fatalf("NavigateToPage: %v", err)
+	}
+	waitForCalls(t, s, "Page.navigate", 1)
+	s.Emit(events...)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return navigation.Response(ctx)
+}
+
+func TestNavigationResponse(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.HandleResult("Page.navigate", devtools.Params{"frameId": "main", "loaderId": "L1"})
+	response, err := navigationResponse(t, s,
+		devtools.EventMessage{Method: "Network.requestWillBeSent", Params: devtools.Params{"requestId": "L1", "type": "Document"}},
+		devtools.EventMessage{Method: "Network.requestWillBeSent", Params: devtools.Params{"requestId": "2", "type": "Script"}},
+		devtools.EventMessage{Method: "Network.responseReceived", Params: devtools.Params{"requestId": "2", "response": devtools.Params{"status": 404}}},
+		devtools.EventMessage{Method: "Network.responseReceivedExtraInfo", Params: devtools.Params{"requestId": "L1", "statusCode": 301}},
+		devtools.EventMessage{Method: "Network.requestWillBeSent", Params: devtools.Params{"requestId": "L1", "type": "Document", "redirectResponse": devtools.Params{
+			"url":     "http://example.com/",
+			"status":  301,
+			"headers": devtools.Params{"Location": "https://www.example.com/"},
+		}}},
+		devtools.EventMessage{Method: "Network.responseReceived", Params: devtools.Params{"requestId": "L1", "response": devtools.Params{
+			"url":           "https://www.example.com/",
+			"status":        200,
+			"statusText":    "OK",
+			"headers":       devtools.Params{"content-type": "text/html"},
+			"mimeType":      "text/html",
+			"securityState": "secure",
+			"hasExtraInfo":  true,
+		}}},
+		devtools.EventMessage{Method: "Network.responseReceivedExtraInfo", Params: devtools.Params{"requestId": "L1", "statusCode": 200, "headers": devtools.Params{
+			"content-type":  "text/html",
+			"cache-control": "max-age=60",
+			"set-cookie":    "a=1\nb=2",
+		}}},
+	)
+	if err != nil {
+		t.Fatalf("Response: %v", err)
+	}
+	if response.URL != "https://www.example.com/" || response.Status != 200 || response.StatusText != "OK" || response.MIMEType != "text/html" || response.SecurityState != "secure" {
+		t.Errorf("Response() = %+v, want the 200 response of https://www.example.com/", response)
+	}
+	if got := response.Header.Get("Cache-Control"); got != "max-age=60" {
+		t.Errorf("Cache-Control = %q, want the one of the raw headers", got)
+	}
+	if got := response.Header.Values("Set-Cookie"); !reflect.DeepEqual(got, []string{"a=1", "b=2"}) {
+		t.Errorf("Set-Cookie = %q, want [a=1 b=2]", got)
+	}
+	if len(response.Redirects) != 1 || response.Redirects[0].Status != 301 || response.Redirects[0].Header.Get("Location") != "https://www.example.com/" {
+		t.Errorf("Redirects = %+v, want the 301 to https://www.example.com/", response.Redirects)
+	}
+}
+
+func TestNavigationWithoutLoader(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	response, err := navigationResponse(t, s,
+		devtools.EventMessage{Method: "Network.requestWillBeSent", Params: devtools.Params{"requestId": "1", "type": "Script"}},
+		devtools.EventMessage{Method: "Network.requestWillBeSent", Params: devtools.Params{"requestId": "2", "type": "Document"}},
+		devtools.EventMessage{Method: "Network.responseReceived", Params: devtools.Params{"requestId": "1", "response": devtools.Params{"status": 500}}},
+		devtools.EventMessage{Method: "Network.responseReceived", Params: devtools.Params{"requestId": "2", "response": devtools.Params{"status": 404}}},
+	)
+	if err != nil || response.Status != 404 {
+		t.Errorf("Response() = %+v, %v, want the 404 of the document", response, err)
+	}
+}
+
+func TestNavigationFailed(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	if _, err := (&Instance{}).NavigateToPage("http://example.com/"); err != ErrNotConnected {
+		t.Errorf("NavigateToPage() before Connect = %v, want %v", err, ErrNotConnected)
+	}
+
+	s.HandleResult("Page.navigate", devtools.Params{"frameId": "main", "errorText": "net::ERR_NAME_NOT_RESOLVED"})
+	chromeInstance := connectToFakeChrome(t, s)
+	defer chromeInstance.DisconnectAndTerminate()
+	if _, err := chromeInstance.NavigateToPage("http://example.com/"); err == nil || !strings.Contains(err.Error(), "net::ERR_NAME_NOT_RESOLVED") {
+		t.Errorf("NavigateToPage() to a page whose host does not exist = %v, want net::ERR_NAME_NOT_RESOLVED", err)
+	}
+
+	s.HandleResult("Page.navigate", devtools.Params{"frameId": "main", "loaderId": "L1"})
+	_, err := navigationResponse(t, s,
+		devtools.EventMessage{Method: "Network.loadingFailed", Params: devtools.Params{"requestId": "L1", "errorText": "net::ERR_CONNECTION_RESET"}},
+	)
+	if err == nil || !strings.Contains(err.Error(), "net::ERR_CONNECTION_RESET") {
+		t.Errorf("Response() of a page failing to load = %v, want net::ERR_CONNECTION_RESET", err)
+	}
+}
+
+// Test that navigations to downloads, which Chrome aborts once their response is received, get the response.
+func TestNavigationAborted(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.Handle("Page.navigate", func(cdptest.Call) (devtools.Params, error) {
+		s.Emit(
+			devtools.EventMessage{Method: "Network.requestWillBeSent", Params: devtools.Params{"requestId": "L1", "type": "Document"}},
+			devtools.EventMessage{Method: "Network.responseReceived", Params: devtools.Params{"requestId": "L1", "response": devtools.Params{
+				"url":          "http://example.com/doc.pdf",
+				"status":       200,
+				"mimeType":     "application/pdf",
+				"hasExtraInfo": true,
+			}}},
+			devtools.EventMessage{Method: "Network.loadingFailed", Params: devtools.Params{"requestId": "L1", "errorText": "net::ERR_ABORTED", "canceled": true}},
+		)
+		return devtools.Params{"frameId": "main", "loaderId": "L1", "errorText": "net::ERR_ABORTED"}, nil
+	})
+	chromeInstance := connectToFakeChrome(t, s)
+	defer chromeInstance.DisconnectAndTerminate()
+	navigation, err := chromeInstance.NavigateToPage("http://example.com/doc.pdf")
+	if err != nil {
+		t.Fatalf("NavigateToPage() to a download: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	response, err := navigation.Response(ctx)
+	if err != nil || response.Status != 200 || response.MIMEType != "application/pdf" {
+		t.Errorf("Response() = %+v, %v, want the 200 of the PDF", response, err)
+	}
+}
+
+func TestNavigationInstanceDies(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	chromeInstance := connectToFakeChrome(t, s)
+	navigation, err := chromeInstance.NavigateToPage("http://example.com/")
+	if err != nil {
+		t.Fatalf("NavigateToPage: %v", err)
+	}
+	waitForCalls(t, s, "Page.navigate", 1)
+	chromeInstance.DisconnectAndTerminate()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := navigation.Response(ctx); err != ErrTerminated {
+		t.Errorf("Response() of a terminated instance = %v, want %v", err, ErrTerminated)
+	}
+}
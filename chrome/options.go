@@ -0,0 +1,197 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultBinary is the Chrome binary started if LaunchOptions.Binary is not set.
+	DefaultBinary = "google-chrome"
+	// DefaultUserDataDirRoot is the directory the user data directories of Chrome are created in,
+	// if LaunchOptions.UserDataDirRoot is not set.
+	DefaultUserDataDirRoot = "/tmp/"
+	// DefaultDevice is the name of the device preset emulated if LaunchOptions.Device is not set.
+	DefaultDevice = "moto-x"
+)
+
+// UserDataDirPolicy selects what happens to the user data directory of a Chrome instance.
+type UserDataDirPolicy int
+
+const (
+	// RemoveUserDataDir deletes the user data directory of each instance when it is terminated.
+	RemoveUserDataDir UserDataDirPolicy = iota
+	// KeepUserDataDir leaves the user data directory of each instance behind, e.g. to inspect
+	// the cache and the crash dumps of Chrome while debugging.
+	KeepUserDataDir
+)
+
+// EmulationProfile describes the device Chrome renders pages for.
+type EmulationProfile struct {
+	Name              string  // The name of the device, e.g. "pixel-7".
+	UserAgent         string  // The User-Agent sent by the device.
+	Width             int     // The width of the viewport, in CSS pixels.
+	Height            int     // The height of the viewport, in CSS pixels.
+	DeviceScaleFactor float64 // The number of device pixels per CSS pixel.
+	Mobile            bool    // Whether to emulate a mobile device, e.g. honoring the meta viewport tag.
+	Touch             bool    // Whether the device has a touch screen.
+}
+
+// DevicePresets are the devices that can be emulated by name, e.g. with the --device flag of the proxies.
+var DevicePresets = map[string]EmulationProfile{
+	"moto-x": {
+		Name:              "moto-x",
+		UserAgent:         userAgentString,
+		Width:             360,
+		Height:            640,
+		DeviceScaleFactor: 2,
+		Mobile:            true,
+		Touch:             true,
+	},
+	"galaxy-a51": {
+		Name:              "galaxy-a51",
+		UserAgent:         "Mozilla/5.0 (Linux; Android 12; SM-A515F) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36",
+		Width:             412,
+		Height:            914,
+		DeviceScaleFactor: 2.625,
+		Mobile:            true,
+		Touch:             true,
+	},
+	"pixel-7": {
+		Name:              "pixel-7",
+		UserAgent:         "Mozilla/5.0 (Linux; Android 14; Pixel 7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36",
+		Width:             412,
+		Height:            915,
+		DeviceScaleFactor: 2.625,
+		Mobile:            true,
+		Touch:             true,
+	},
+	"iphone-14": {
+		Name:              "iphone-14",
+		UserAgent:         "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/120.0.6099.119 Mobile/15E148 Safari/604.1",
+		Width:             390,
+		Height:            844,
+		DeviceScaleFactor: 3,
+		Mobile:            true,
+		Touch:             true,
+	},
+	"ipad": {
+		Name:              "ipad",
+		UserAgent:         "Mozilla/5.0 (iPad; CPU OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/120.0.6099.119 Mobile/15E148 Safari/604.1",
+		Width:             810,
+		Height:            1080,
+		DeviceScaleFactor: 2,
+		Mobile:            true,
+		Touch:             true,
+	},
+	"desktop": {
+		Name:              "desktop",
+		UserAgent:         "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+		Width:             1366,
+		Height:            768,
+		DeviceScaleFactor: 1,
+	},
+}
+
+// DevicePreset returns the device preset with the name, one of DevicePresetNames.
+func DevicePreset(name string) (EmulationProfile, error) {
+	profile, ok := DevicePresets[name]
+	if !ok {
+		return EmulationProfile{}, fmt.Errorf("unknown device %q, want one of: %v", name, strings.Join(DevicePresetNames(), ", "))
+	}
+	return profile, nil
+}
+
+// DevicePresetNames returns the sorted names of the device presets.
+func DevicePresetNames() []string {
+	var names []string
+	for name := range DevicePresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LaunchOptions configures how Chrome instances are started, and the device they render pages for.
+// The zero value starts headless google-chrome emulating the DefaultDevice.
+type LaunchOptions struct {
+	Binary          string            // The Chrome binary. Defaults to DefaultBinary.
+	UseFullChrome   bool              // Whether to start Chrome with its graphical interface instead of headless.
+	Flags           []string          // Extra command line flags of Chrome, e.g. "--disable-gpu".
+	ProxyServer     string            // If set, Chrome fetches pages through this proxy, e.g. "http://localhost:3128".
+	WindowWidth     int               // If set with WindowHeight, the size of the window of Chrome.
+	WindowHeight    int               // If set with WindowWidth, the size of the window of Chrome.
+	Device          *EmulationProfile // The device to emulate. Defaults to the DefaultDevice preset.
+	UserAgent       string            // If set, overrides the User-Agent of Device.
+	Locale          string            // If set, the locale of Chrome, e.g. "fr-FR", for Accept-Language and Intl.
+	Timezone        string            // If set, the IANA timezone of Chrome, e.g. "Europe/Paris".
+	UserDataDirRoot string            // The directory user data directories are created in. Defaults to DefaultUserDataDirRoot.
+	UserDataDir     UserDataDirPolicy // What happens to the user data directory of an instance when it is terminated.
+	Limits          ResourceLimits    // The resources Chrome processes may use.
+	Budget          Budget            // The resources each page may use.
+	Interceptor     *Interceptor      // If set, blocks, rewrites or mocks the requests of pages.
+	Forward         ForwardPolicy     // The headers of clients forwarded to Chrome, see Instance.SetClientHeaders.
+	// When pages are deemed stable, e.g. to serve their DOM. Defaults to DefaultStability.
+	// Instance.SetStability overrides it for one page.
+	Stability StabilityCondition
+}
+
+// device returns the device to emulate, with the User-Agent override applied.
+func (o LaunchOptions) device() EmulationProfile {
+	profile := DevicePresets[DefaultDevice]
+	if o.Device != nil {
+		profile = *o.Device
+	}
+	if o.UserAgent != "" {
+		profile.UserAgent = o.UserAgent
+	}
+	return profile
+}
+
+// args returns the command line flags of Chrome, besides the DevTools and user data directory ones.
+func (o LaunchOptions) args() []string {
+	var args []string
+	if !o.UseFullChrome {
+		args = append(args, "--headless")
+	}
+	if o.ProxyServer != "" {
+		args = append(args, "--proxy-server="+o.ProxyServer)
+	}
+	if o.WindowWidth > 0 && o.WindowHeight > 0 {
+		args = append(args, fmt.Sprintf("--window-size=%d,%d", o.WindowWidth, o.WindowHeight))
+	}
+	if o.Locale != "" {
+		args = append(args, "--lang="+o.Locale)
+	}
+	return append(args, o.Flags...)
+}
+
+// ParseWindowSize parses a window size formatted as "<width>x<height>", e.g. "1280x800".
+func ParseWindowSize(size string) (width, height int, err error) {
+	parts := strings.Split(size, "x")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("malformed window size %q, want <width>x<height>", size)
+	}
+	width, werr := strconv.Atoi(parts[0])
+	height, herr := strconv.Atoi(parts[1])
+	if werr != nil || herr != nil || width <= 0 || height <= 0 {
+		return 0, 0, fmt.Errorf("malformed window size %q, want <width>x<height>", size)
+	}
+	return width, height, nil
+}
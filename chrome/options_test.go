@@ -0,0 +1,126 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"streaming_hdp/devtools/cdptest"
+)
+
+func TestLaunchOptionsArgs(t *testing.T) {
+	testCases := []struct {
+		name string
+		opts LaunchOptions
+		want []string
+	}{
+		{"default", LaunchOptions{}, []string{"--headless"}},
+		{"full_chrome", LaunchOptions{UseFullChrome: true}, nil},
+		{"all", LaunchOptions{
+			ProxyServer:  "http://localhost:3128",
+			WindowWidth:  1280,
+			WindowHeight: 800,
+			Locale:       "fr-FR",
+			Flags:        []string{"--disable-gpu"},
+		}, []string{"--headless", "--proxy-server=http://localhost:3128", "--window-size=1280,800", "--lang=fr-FR", "--disable-gpu"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.opts.args(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("args() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDevicePreset(t *testing.T) {
+	if got := (LaunchOptions{}).device(); !reflect.DeepEqual(got, DevicePresets[DefaultDevice]) {
+		t.Errorf("device() of the zero options = %+v, want the %v preset", got, DefaultDevice)
+	}
+	profile, err := DevicePreset("pixel-7")
+	if err != nil {
+		t.Fatalf("DevicePreset(pixel-7): %v", err)
+	}
+	got := LaunchOptions{Device: &profile, UserAgent: "test-agent"}.device()
+	if got.Width != 412 || got.UserAgent != "test-agent" {
+		t.Errorf("device() = %+v, want the pixel-7 preset with the User-Agent test-agent", got)
+	}
+	if _, err := DevicePreset("nokia-3310"); err == nil {
+		t.Errorf("DevicePreset(nokia-3310) succeeded, want an error")
+	}
+}
+
+func TestParseWindowSize(t *testing.T) {
+	if width, height, err := ParseWindowSize("1280x800"); err != nil || width != 1280 || height != 800 {
+		t.Errorf("ParseWindowSize(1280x800) = %v, %v, %v, want 1280, 800, nil", width, height, err)
+	}
+	for _, size := range []string{"", "1280", "1280x", "x800", "-1x800", "1280x800x2"} {
+		if _, _, err := ParseWindowSize(size); err == nil {
+			t.Errorf("ParseWindowSize(%q) succeeded, want an error", size)
+		}
+	}
+}
+
+func TestEmulateDevice(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	chromeInstance := connectToFakeChrome(t, s)
+	defer chromeInstance.DisconnectAndTerminate()
+	profile := DevicePresets["desktop"]
+	chromeInstance.options = LaunchOptions{Device: &profile, Locale: "fr-FR", Timezone: "Europe/Paris"}
+
+	if _, err := chromeInstance.NavigateToPage("http://example.com/"); err != nil {
+		t.Fatalf("NavigateToPage: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := s.WaitForCall(ctx, "Page.navigate"); err != nil {
+		t.Fatalf("Page.navigate was not invoked: %v", err)
+	}
+
+	metrics := s.CallsTo("Emulation.setDeviceMetricsOverride")
+	if len(metrics) != 1 {
+		t.Fatalf("Emulation.setDeviceMetricsOverride invoked %v times, want 1", len(metrics))
+	}
+	width, _ := metrics[0].Params.Int("width")
+	mobile, _ := metrics[0].Params["mobile"].(bool)
+	if width != 1366 || mobile {
+		t.Errorf("Emulation.setDeviceMetricsOverride params = %v, want the desktop preset", metrics[0].Params)
+	}
+	if calls := s.CallsTo("Emulation.setTouchEmulationEnabled"); len(calls) != 0 {
+		t.Errorf("Emulation.setTouchEmulationEnabled invoked for a device without touch screen")
+	}
+	ua := s.CallsTo("Network.setUserAgentOverride")
+	if len(ua) != 1 {
+		t.Fatalf("Network.setUserAgentOverride invoked %v times, want 1", len(ua))
+	}
+	if language, _ := ua[0].Params.String("acceptLanguage"); language != "fr-FR" {
+		t.Errorf("acceptLanguage = %q, want fr-FR", language)
+	}
+	locale := s.CallsTo("Emulation.setLocaleOverride")
+	if len(locale) != 1 {
+		t.Errorf("Emulation.setLocaleOverride invoked %v times, want 1", len(locale))
+	}
+	timezone := s.CallsTo("Emulation.setTimezoneOverride")
+	if len(timezone) != 1 {
+		t.Fatalf("Emulation.setTimezoneOverride invoked %v times, want 1", len(timezone))
+	}
+	if id, _ := timezone[0].Params.String("timezoneId"); id != "Europe/Paris" {
+		t.Errorf("timezoneId = %q, want Europe/Paris", id)
+	}
+}
@@ -0,0 +1,546 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"streaming_hdp/devtools"
+)
+
+const (
+	// The virtual time budget of the DefaultStability.
+	defaultVirtualTimeBudget = 5 * time.Second
+	// The binding the pages call when their DOM changes, for DOMQuiet.
+	domMutationBinding = "__hdpDOMMutated"
+	// The isolated world DOMQuiet observes the DOM from, out of reach of the scripts of the pages.
+	domMutationWorld = "__hdpDOMQuiet"
+)
+
+// Installed in the isolated world of every document of the tab by DOMQuiet: calls the binding once the
+// document is created, and whenever its DOM changes.
+var domMutationObserver = `(() => {
+	const binding = window.` + domMutationBinding + `;
+	const notify = () => binding('');
+	notify();
+	new MutationObserver(notify).observe(document, {childList: true, subtree: true, attributes: true, characterData: true});
+})();`
+
+// StabilityCondition decides when the page loaded in a tab has stabilized, e.g. to serve its DOM.
+// Conditions are combined with AllOf and AnyOf.
+type StabilityCondition interface {
+	// Watch starts watching the page about to be navigated to in the tab, and returns a channel closed
+	// once the page is stable. It is called before navigating, and stops watching once ctx is done.
+	Watch(ctx context.Context, tab devtools.Target) <-chan struct{}
+	// String describes the condition, in the syntax of ParseStability.
+	String() string
+}
+
+// DefaultStability returns the condition pages must meet if LaunchOptions.Stability is not set: a
+// virtual time budget of 5s.
+func DefaultStability() StabilityCondition {
+	return VirtualTimeBudget(defaultVirtualTimeBudget)
+}
+
+// VirtualTimeBudget returns a condition met once the page has run for budget of virtual time, which
+// does not advance while network fetches are pending. See Emulation.setVirtualTimePolicy.
+func VirtualTimeBudget(budget time.Duration) StabilityCondition {
+	return virtualTimeBudget(budget)
+}
+
+type virtualTimeBudget time.Duration
+
+func (b virtualTimeBudget) Watch(ctx context.Context, tab devtools.Target) <-chan struct{} {
+	expired, cancel := tab.Subscribe("Emulation.virtualTimeBudgetExpired")
+	callCtx, cancelCall := context.WithTimeout(ctx, methodTimeout)
+	defer cancelCall()
+	_, err := tab.Call(callCtx, "Emulation.setVirtualTimePolicy", devtools.Params{
+		"policy": "pauseIfNetworkFetchesPending",
+		"budget": int(time.Duration(b) / time.Millisecond),
+	})
+	if err != nil {
+		fmt.Printf("method invocation error: %v\n", err)
+	}
+	return untilEvent(ctx, expired, cancel, func(devtools.EventMessage) bool { return true })
+}
+
+func (b virtualTimeBudget) String() string {
+	return "virtual-time=" + time.Duration(b).String()
+}
+
+// NetworkIdle returns a condition met once at most maxInflight network requests of the page have been
+// in flight for quiet, after its first request.
+func NetworkIdle(maxInflight int, quiet time.Duration) StabilityCondition {
+	return networkIdle{maxInflight, quiet}
+}
+
+type networkIdle struct {
+	maxInflight int
+	quiet       time.Duration
+}
+
+func (n networkIdle) Watch(ctx context.Context, tab devtools.Target) <-chan struct{} {
+	events, cancel := tab.Subscribe("Network.*")
+	tab.InvokeMethod("Network.enable", devtools.Params{})
+	stable := make(chan struct{})
+	go func() {
+		defer cancel()
+		q := newQuietTimer(n.quiet)
+		defer q.stop()
+		inflight := make(map[string]bool)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-q.expired():
+				close(stable)
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				id, _ := event.Params.String("requestId")
+				switch event.Method {
+				case "Network.requestWillBeSent":
+					inflight[id] = true
+				case "Network.loadingFinished", "Network.loadingFailed":
+					if !inflight[id] {
+						// A request of the previous page.
+						continue
+					}
+					delete(inflight, id)
+				default:
+					continue
+				}
+				if len(inflight) > n.maxInflight {
+					q.stop()
+				} else if !q.running() {
+					q.start()
+				}
+			}
+		}
+	}()
+	return stable
+}
+
+func (n networkIdle) String() string {
+	if n.maxInflight == 0 {
+		return "network-idle=" + n.quiet.String()
+	}
+	return fmt.Sprintf("network-idle=%d/%v", n.maxInflight, n.quiet)
+}
+
+// DOMQuiet returns a condition met once the DOM of the page has not changed for quiet, as observed by a
+// MutationObserver installed in an isolated world of the page, which its scripts can neither see nor call.
+func DOMQuiet(quiet time.Duration) StabilityCondition {
+	return domQuiet(quiet)
+}
+
+type domQuiet time.Duration
+
+func (d domQuiet) Watch(ctx context.Context, tab devtools.Target) <-chan struct{} {
+	calls, cancel := tab.Subscribe("Runtime.bindingCalled")
+	tab.InvokeMethod("Runtime.enable", devtools.Params{})
+	tab.InvokeMethod("Runtime.addBinding", devtools.Params{"name": domMutationBinding, "executionContextName": domMutationWorld})
+	tab.InvokeMethod("Page.addScriptToEvaluateOnNewDocument", devtools.Params{"source": domMutationObserver, "worldName": domMutationWorld})
+	stable := make(chan struct{})
+	go func() {
+		defer cancel()
+		q := newQuietTimer(time.Duration(d))
+		defer q.stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-q.expired():
+				close(stable)
+				return
+			case call, ok := <-calls:
+				if !ok {
+					return
+				}
+				if name, _ := call.Params.String("name"); name == domMutationBinding {
+					q.start()
+				}
+			}
+		}
+	}()
+	return stable
+}
+
+func (d domQuiet) String() string {
+	return "dom-quiet=" + time.Duration(d).String()
+}
+
+// LifecycleEvent returns a condition met once the main frame of the page reaches the lifecycle milestone
+// name, e.g. "firstMeaningfulPaint" or "networkAlmostIdle". See Page.lifecycleEvent.
+func LifecycleEvent(name string) StabilityCondition {
+	return lifecycleEvent(name)
+}
+
+type lifecycleEvent string
+
+func (l lifecycleEvent) Watch(ctx context.Context, tab devtools.Target) <-chan struct{} {
+	events, cancel := tab.Subscribe("Page.lifecycleEvent")
+	callCtx, cancelCall := context.WithTimeout(ctx, methodTimeout)
+	defer cancelCall()
+	frameTree, err := tab.Call(callCtx, "Page.getFrameTree", devtools.Params{})
+	if err != nil {
+		fmt.Printf("failed to get the main frame of the tab: %v\n", err)
+	}
+	mainFrame, _ := frameTree.String("frameTree.frame.id")
+	currentLoader, _ := frameTree.String("frameTree.frame.loaderId")
+	tab.InvokeMethod("Page.enable", devtools.Params{})
+	tab.InvokeMethod("Page.setLifecycleEventsEnabled", devtools.Params{"enabled": true})
+
+	// Enabling the events replays the milestones of the current document: only the milestones of the
+	// documents loaded next count.
+	loaderID := ""
+	return untilEvent(ctx, events, cancel, func(event devtools.EventMessage) bool {
+		if frame, _ := event.Params.String("frameId"); mainFrame != "" && frame != mainFrame {
+			return false
+		}
+		name, _ := event.Params.String("name")
+		loader, _ := event.Params.String("loaderId")
+		if name == "init" && loader != currentLoader {
+			loaderID = loader
+			return false
+		}
+		return loaderID != "" && loader == loaderID && name == string(l)
+	})
+}
+
+func (l lifecycleEvent) String() string {
+	return "lifecycle=" + string(l)
+}
+
+// WallClock returns a condition met once d has elapsed since navigating, e.g. to cap the time spent
+// waiting for other conditions with AnyOf.
+func WallClock(d time.Duration) StabilityCondition {
+	return wallClock(d)
+}
+
+type wallClock time.Duration
+
+func (w wallClock) Watch(ctx context.Context, tab devtools.Target) <-chan struct{} {
+	stable := make(chan struct{})
+	go func() {
+		timer := time.NewTimer(time.Duration(w))
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			close(stable)
+		case <-ctx.Done():
+		}
+	}()
+	return stable
+}
+
+func (w wallClock) String() string {
+	return "max=" + time.Duration(w).String()
+}
+
+// AllOf returns a condition met once all the conditions are met.
+func AllOf(conditions ...StabilityCondition) StabilityCondition {
+	if len(conditions) == 1 {
+		return conditions[0]
+	}
+	return allOf(conditions)
+}
+
+type allOf []StabilityCondition
+
+func (a allOf) Watch(ctx context.Context, tab devtools.Target) <-chan struct{} {
+	var watched []<-chan struct{}
+	for _, condition := range a {
+		watched = append(watched, condition.Watch(ctx, tab))
+	}
+	stable := make(chan struct{})
+	go func() {
+		for _, met := range watched {
+			select {
+			case <-met:
+			case <-ctx.Done():
+				return
+			}
+		}
+		close(stable)
+	}()
+	return stable
+}
+
+func (a allOf) String() string {
+	return joinConditions(a, ",")
+}
+
+// AnyOf returns a condition met once any of the conditions is met.
+func AnyOf(conditions ...StabilityCondition) StabilityCondition {
+	if len(conditions) == 1 {
+		return conditions[0]
+	}
+	return anyOf(conditions)
+}
+
+type anyOf []StabilityCondition
+
+func (a anyOf) Watch(ctx context.Context, tab devtools.Target) <-chan struct{} {
+	// The other conditions stop being watched once one is met.
+	watchCtx, cancel := context.WithCancel(ctx)
+	stable := make(chan struct{})
+	var once sync.Once
+	for _, condition := range a {
+		met := condition.Watch(watchCtx, tab)
+		go func() {
+			select {
+			case <-met:
+				once.Do(func() { close(stable) })
+			case <-watchCtx.Done():
+			}
+		}()
+	}
+	go func() {
+		select {
+		case <-stable:
+		case <-ctx.Done():
+		}
+		cancel()
+	}()
+	return stable
+}
+
+func (a anyOf) String() string {
+	return joinConditions(a, "|")
+}
+
+// joinConditions joins the descriptions of the conditions with sep.
+func joinConditions(conditions []StabilityCondition, sep string) string {
+	var descriptions []string
+	for _, condition := range conditions {
+		descriptions = append(descriptions, condition.String())
+	}
+	return strings.Join(descriptions, sep)
+}
+
+// ParseStability parses a stability condition. Alternatives separated by "|" are met once any of them
+// is, and conditions separated by "," once all of them are. The conditions are:
+//
+//	virtual-time=5s      VirtualTimeBudget(5 * time.Second)
+//	network-idle=500ms   NetworkIdle(0, 500 * time.Millisecond)
+//	network-idle=2/500ms NetworkIdle(2, 500 * time.Millisecond)
+//	dom-quiet=1s         DOMQuiet(time.Second)
+//	lifecycle=name       LifecycleEvent(name)
+//	max=10s              WallClock(10 * time.Second)
+//
+// For example, "network-idle=500ms,dom-quiet=1s|max=10s" waits for both the network and the DOM to be
+// quiet, for at most 10s.
+func ParseStability(spec string) (StabilityCondition, error) {
+	var alternatives []StabilityCondition
+	for _, alternative := range strings.Split(spec, "|") {
+		var conditions []StabilityCondition
+		for _, s := range strings.Split(alternative, ",") {
+			condition, err := parseCondition(strings.TrimSpace(s))
+			if err != nil {
+				return nil, err
+			}
+			conditions = append(conditions, condition)
+		}
+		alternatives = append(alternatives, AllOf(conditions...))
+	}
+	return AnyOf(alternatives...), nil
+}
+
+// parseCondition parses one condition of ParseStability.
+func parseCondition(s string) (StabilityCondition, error) {
+	parts := strings.SplitN(s, "=", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("malformed stability condition %q, want <name>=<value>", s)
+	}
+	name, value := parts[0], parts[1]
+	if name == "lifecycle" {
+		return LifecycleEvent(value), nil
+	}
+	maxInflight := 0
+	if name == "network-idle" {
+		if i := strings.Index(value, "/"); i >= 0 {
+			n, err := strconv.Atoi(value[:i])
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("malformed number of requests in %q", s)
+			}
+			maxInflight, value = n, value[i+1:]
+		}
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return nil, fmt.Errorf("malformed duration in stability condition %q", s)
+	}
+	switch name {
+	case "virtual-time":
+		return VirtualTimeBudget(d), nil
+	case "network-idle":
+		return NetworkIdle(maxInflight, d), nil
+	case "dom-quiet":
+		return DOMQuiet(d), nil
+	case "max":
+		return WallClock(d), nil
+	}
+	return nil, fmt.Errorf("unknown stability condition %q, want one of virtual-time, network-idle, dom-quiet, lifecycle or max", name)
+}
+
+// SetStability sets the condition the next page navigated to must meet to be stable, overriding
+// LaunchOptions.Stability, e.g. as selected by the request for the page. Reset clears it.
+func (c *Instance) SetStability(condition StabilityCondition) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.page.stability = condition
+}
+
+// Stable returns a channel closed once the page navigated to is stable. It can be called before
+// navigating: the channel is the one of the next page until Reset.
+func (c *Instance) Stable() <-chan struct{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stableLocked()
+}
+
+// stableLocked implements Stable, replacing the channel of the previous page if it is stable already.
+// c.mu must be held.
+func (c *Instance) stableLocked() chan struct{} {
+	if c.page.stable != nil {
+		select {
+		case <-c.page.stable:
+		default:
+			return c.page.stable
+		}
+	}
+	c.page.stable = make(chan struct{})
+	c.page.stableOnce = new(sync.Once)
+	return c.page.stable
+}
+
+// WaitUntilStable blocks until the page navigated to is stable, or the instance dies. Returns why the
+// instance died, if it did first.
+func (c *Instance) WaitUntilStable() error {
+	stable := c.Stable()
+	select {
+	case <-stable:
+		return nil
+	case <-c.Dead():
+	}
+	select {
+	case <-stable:
+		return nil
+	default:
+		return c.Err()
+	}
+}
+
+// watchStability starts watching for the page about to be navigated to to be stable, until its
+// lifecycle ends.
+func (c *Instance) watchStability() {
+	ctx := c.Context()
+	c.mu.Lock()
+	condition := c.page.stability
+	if condition == nil {
+		condition = c.options.Stability
+	}
+	if condition == nil {
+		condition = DefaultStability()
+	}
+	stable, once := c.stableLocked(), c.page.stableOnce
+	target := c.target
+	c.mu.Unlock()
+	if target == nil {
+		return
+	}
+
+	met := condition.Watch(ctx, target)
+	go func() {
+		select {
+		case <-met:
+			fmt.Printf("chrome instance %p: the page is stable: %v\n", c, condition)
+			once.Do(func() { close(stable) })
+		case <-ctx.Done():
+		}
+	}()
+}
+
+// untilEvent returns a channel closed once an event of the subscription matches, until ctx is done or
+// the subscription ends. cancel ends the subscription.
+func untilEvent(ctx context.Context, events <-chan devtools.EventMessage, cancel func(), match func(devtools.EventMessage) bool) <-chan struct{} {
+	stable := make(chan struct{})
+	go func() {
+		defer cancel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				if match(event) {
+					close(stable)
+					return
+				}
+			}
+		}
+	}()
+	return stable
+}
+
+// quietTimer expires once it has been started, and not restarted or stopped, for a quiet period.
+type quietTimer struct {
+	quiet   time.Duration
+	timer   *time.Timer
+	stopped bool // Whether the timer is stopped.
+}
+
+// newQuietTimer returns a quietTimer of the quiet period, not started yet.
+func newQuietTimer(quiet time.Duration) *quietTimer {
+	return &quietTimer{quiet: quiet, stopped: true}
+}
+
+// start starts the quiet period over.
+func (q *quietTimer) start() {
+	q.stop()
+	q.timer = time.NewTimer(q.quiet)
+	q.stopped = false
+}
+
+// stop stops the timer, until started again.
+func (q *quietTimer) stop() {
+	if q.timer != nil {
+		q.timer.Stop()
+	}
+	q.stopped = true
+}
+
+// running returns whether the quiet period is being timed.
+func (q *quietTimer) running() bool {
+	return !q.stopped
+}
+
+// expired returns a channel receiving once the quiet period elapsed, or nil while the timer is stopped.
+func (q *quietTimer) expired() <-chan time.Time {
+	if q.stopped {
+		return nil
+	}
+	return q.timer.C
+}
@@ -0,0 +1,210 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"streaming_hdp/devtools"
+	"streaming_hdp/devtools/cdptest"
+)
+
+// navigateWithStability navigates the instance connected to the fake Chrome to a page that must meet
+// the condition to be stable.
+func navigateWithStability(t *testing.T, s *cdptest.Server, condition StabilityCondition) *Instance {
+	chromeInstance := connectToFakeChrome(t, s)
+	chromeInstance.SetStability(condition)
+	if _, err := chromeInstance.NavigateToPage("http://example.com/"); err != nil {
+		t.Fatalf("NavigateToPage: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := s.WaitForCall(ctx, "Page.navigate"); err != nil {
+		t.Fatalf("Page.navigate was not invoked: %v", err)
+	}
+	return chromeInstance
+}
+
+// expectStable checks whether the page of the instance becomes stable within wait.
+func expectStable(t *testing.T, chromeInstance *Instance, want bool, wait time.Duration) {
+	t.Helper()
+	select {
+	case <-chromeInstance.Stable():
+		if !want {
+			t.Fatalf("the page is stable too early")
+		}
+	case <-time.After(wait):
+		if want {
+			t.Fatalf("the page did not become stable")
+		}
+	}
+}
+
+func TestParseStability(t *testing.T) {
+	for _, spec := range []string{
+		"virtual-time=5s",
+		"network-idle=500ms",
+		"network-idle=2/500ms",
+		"dom-quiet=1s",
+		"lifecycle=networkAlmostIdle",
+		"max=10s",
+		"network-idle=500ms,dom-quiet=1s|max=10s",
+		"lifecycle=firstMeaningfulPaint|virtual-time=3s,max=1m0s",
+	} {
+		condition, err := ParseStability(spec)
+		if err != nil {
+			t.Errorf("ParseStability(%q): %v", spec, err)
+			continue
+		}
+		if got := condition.String(); got != spec {
+			t.Errorf("ParseStability(%q).String() = %q", spec, got)
+		}
+	}
+	for _, spec := range []string{"", "max", "max=", "max=soon", "network-idle=-1/1s", "network-idle=x/1s", "dom-quiet=-1s", "paint=1s", "max=1s,"} {
+		if _, err := ParseStability(spec); err == nil {
+			t.Errorf("ParseStability(%q) succeeded, want an error", spec)
+		}
+	}
+}
+
+func TestVirtualTimeBudgetStability(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	chromeInstance := navigateWithStability(t, s, nil)
+	defer chromeInstance.DisconnectAndTerminate()
+
+	calls := s.CallsTo("Emulation.setVirtualTimePolicy")
+	if len(calls) != 1 {
+		t.Fatalf("Emulation.setVirtualTimePolicy invoked %v times, want 1", len(calls))
+	}
+	if budget, _ := calls[0].Params.Int("budget"); budget != 5000 {
+		t.Errorf("virtual time budget = %v, want 5000", budget)
+	}
+	expectStable(t, chromeInstance, false, 50*time.Millisecond)
+	s.Emit(devtools.EventMessage{Method: "Emulation.virtualTimeBudgetExpired", Params: devtools.Params{}})
+	if err := chromeInstance.WaitUntilStable(); err != nil {
+		t.Errorf("WaitUntilStable: %v", err)
+	}
+}
+
+func TestNetworkIdleStability(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	chromeInstance := navigateWithStability(t, s, NetworkIdle(1, 50*time.Millisecond))
+	defer chromeInstance.DisconnectAndTerminate()
+	if calls := s.CallsTo("Emulation.setVirtualTimePolicy"); len(calls) != 0 {
+		t.Errorf("Emulation.setVirtualTimePolicy invoked without a virtual time budget")
+	}
+
+	// The network is not idle before the first request.
+	expectStable(t, chromeInstance, false, 100*time.Millisecond)
+	request := func(method, id string) devtools.EventMessage {
+		return devtools.EventMessage{Method: method, Params: devtools.Params{"requestId": id}}
+	}
+	s.Emit(
+		request("Network.requestWillBeSent", "1"),
+		request("Network.requestWillBeSent", "2"),
+		request("Network.loadingFinished", "unknown"),
+	)
+	expectStable(t, chromeInstance, false, 100*time.Millisecond)
+	// One request may stay in flight.
+	s.Emit(request("Network.loadingFailed", "1"))
+	expectStable(t, chromeInstance, true, 5*time.Second)
+}
+
+func TestDOMQuietStability(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	chromeInstance := navigateWithStability(t, s, DOMQuiet(100*time.Millisecond))
+	defer chromeInstance.DisconnectAndTerminate()
+	// The observer and its binding must be out of reach of the scripts of the page.
+	if calls := s.CallsTo("Page.addScriptToEvaluateOnNewDocument"); len(calls) != 1 || calls[0].Params["worldName"] != domMutationWorld {
+		t.Fatalf("Page.addScriptToEvaluateOnNewDocument invoked with %+v, want once in the isolated world %v", calls, domMutationWorld)
+	}
+	if calls := s.CallsTo("Runtime.addBinding"); len(calls) != 1 || calls[0].Params["executionContextName"] != domMutationWorld {
+		t.Fatalf("Runtime.addBinding invoked with %+v, want once for the isolated world %v", calls, domMutationWorld)
+	}
+
+	mutated := devtools.EventMessage{Method: "Runtime.bindingCalled", Params: devtools.Params{"name": domMutationBinding, "payload": ""}}
+	for i := 0; i < 5; i++ {
+		s.Emit(mutated)
+		expectStable(t, chromeInstance, false, 30*time.Millisecond)
+	}
+	expectStable(t, chromeInstance, true, 5*time.Second)
+}
+
+func TestLifecycleEventStability(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.HandleResult("Page.getFrameTree", devtools.Params{"frameTree": devtools.Params{"frame": devtools.Params{"id": "main", "loaderId": "blank"}}})
+	chromeInstance := navigateWithStability(t, s, LifecycleEvent("networkAlmostIdle"))
+	defer chromeInstance.DisconnectAndTerminate()
+
+	lifecycle := func(frame, loader, name string) devtools.EventMessage {
+		return devtools.EventMessage{Method: "Page.lifecycleEvent", Params: devtools.Params{"frameId": frame, "loaderId": loader, "name": name}}
+	}
+	s.Emit(
+		// The milestones of the blank page, replayed once the events are enabled.
+		lifecycle("main", "blank", "init"),
+		lifecycle("main", "blank", "networkAlmostIdle"),
+		lifecycle("main", "page", "init"),
+		lifecycle("frame", "ad", "init"),
+		lifecycle("frame", "ad", "networkAlmostIdle"),
+		lifecycle("main", "page", "load"),
+	)
+	expectStable(t, chromeInstance, false, 100*time.Millisecond)
+	s.Emit(lifecycle("main", "page", "networkAlmostIdle"))
+	expectStable(t, chromeInstance, true, 5*time.Second)
+}
+
+func TestCombinedStability(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	condition, err := ParseStability("virtual-time=5s,dom-quiet=50ms|max=200ms")
+	if err != nil {
+		t.Fatalf("ParseStability: %v", err)
+	}
+
+	chromeInstance := navigateWithStability(t, s, condition)
+	s.Emit(devtools.EventMessage{Method: "Runtime.bindingCalled", Params: devtools.Params{"name": domMutationBinding}})
+	// The DOM is quiet, but the virtual time budget has not expired.
+	expectStable(t, chromeInstance, false, 100*time.Millisecond)
+	s.Emit(devtools.EventMessage{Method: "Emulation.virtualTimeBudgetExpired", Params: devtools.Params{}})
+	expectStable(t, chromeInstance, true, 50*time.Millisecond)
+	chromeInstance.DisconnectAndTerminate()
+
+	// The wall-clock cap is met even if the others never are.
+	start := time.Now()
+	chromeInstance = navigateWithStability(t, s, condition)
+	expectStable(t, chromeInstance, true, 5*time.Second)
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("the page is stable after %v, before its wall-clock cap", elapsed)
+	}
+	chromeInstance.DisconnectAndTerminate()
+}
+
+func TestWaitUntilStableDies(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	chromeInstance := navigateWithStability(t, s, WallClock(time.Minute))
+	crashed := errors.New("the tab crashed")
+	go chromeInstance.die(crashed)
+	if err := chromeInstance.WaitUntilStable(); err != crashed {
+		t.Errorf("WaitUntilStable() = %v, want %v", err, crashed)
+	}
+}
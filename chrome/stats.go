@@ -0,0 +1,132 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"sort"
+	"time"
+)
+
+// InstanceState is the state of a Chrome instance of an InstanceManager.
+type InstanceState string
+
+const (
+	// InstanceIdle is the state of the instances started and waiting to be handed out.
+	InstanceIdle InstanceState = "idle"
+	// InstanceInUse is the state of the instances handed out, rendering a page.
+	InstanceInUse InstanceState = "in-use"
+	// InstanceDead is the state of the instances that died and are about to be removed.
+	InstanceDead InstanceState = "dead"
+)
+
+// InstanceStats describes a Chrome instance of an InstanceManager.
+type InstanceStats struct {
+	ID          int           `json:"id"`
+	Port        int           `json:"port,omitempty"`   // The DevTools port of Chrome. Zero for the DevTools pipe.
+	PID         int           `json:"pid,omitempty"`    // The process of Chrome. Zero for Chrome on other hosts.
+	Remote      string        `json:"remote,omitempty"` // The address of Chrome on another host, if it is.
+	State       InstanceState `json:"state"`
+	URL         string        `json:"url,omitempty"`   // The page rendered by the instance in use.
+	Age         time.Duration `json:"age"`             // How long ago the instance began its first page, see Instance.Age.
+	LastEvent   time.Time     `json:"last_event"`      // When the tab of the instance last received an event. Zero if it never did.
+	Navigations int           `json:"navigations"`     // The number of pages navigated to, across resets.
+	Healthy     bool          `json:"healthy"`         // Whether the connection to Chrome is healthy, see Instance.Healthy.
+	Err         string        `json:"error,omitempty"` // Why the instance died, if it did.
+}
+
+// Stats describes what an InstanceManager is doing.
+type Stats struct {
+	Idle           int             `json:"idle"`            // The number of instances waiting to be handed out.
+	InUse          int             `json:"in_use"`          // The number of instances handed out.
+	Starting       int             `json:"starting"`        // The number of instances being started or reset.
+	Waiting        int             `json:"waiting"`         // The number of callers waiting for an instance.
+	LaunchFailures int             `json:"launch_failures"` // The number of instances that failed to start or connect.
+	Closed         bool            `json:"closed"`          // Whether the manager is closed.
+	Instances      []InstanceStats `json:"instances"`       // The instances, by ID.
+}
+
+// Stats returns the state of the instance manager and of each of its instances.
+func (im *InstanceManager) Stats() Stats {
+	im.instancesMutex.Lock()
+	stats := Stats{
+		Starting:       im.starting,
+		Waiting:        im.waiting,
+		LaunchFailures: im.launchFailures,
+		Closed:         im.closed,
+	}
+	instances := make(map[int]*Instance, len(im.instances))
+	urls := make(map[int]string, len(im.urls))
+	for id, chromeInstance := range im.instances {
+		instances[id] = chromeInstance
+	}
+	for id, url := range im.urls {
+		urls[id] = url
+	}
+	im.instancesMutex.Unlock()
+
+	// The instances are inspected without holding the lock of the manager.
+	for id, chromeInstance := range instances {
+		s := chromeInstance.stats()
+		s.ID = id
+		url, inUse := urls[id]
+		switch {
+		case s.Err != "":
+			s.State = InstanceDead
+		case inUse:
+			s.State = InstanceInUse
+		default:
+			s.State = InstanceIdle
+		}
+		if inUse {
+			s.URL = url
+			stats.InUse++
+		} else {
+			stats.Idle++
+		}
+		stats.Instances = append(stats.Instances, s)
+	}
+	sort.Slice(stats.Instances, func(i, j int) bool {
+		return stats.Instances[i].ID < stats.Instances[j].ID
+	})
+	return stats
+}
+
+// stats describes the instance, besides its ID, state and URL, which are up to its manager.
+func (c *Instance) stats() InstanceStats {
+	// Tabs report the Chrome process they share.
+	process := c
+	if c.parent != nil {
+		process = c.parent
+	}
+	s := InstanceStats{
+		Port:        process.port,
+		Remote:      process.remote,
+		Age:         c.Age(),
+		Navigations: c.Navigations(),
+		Healthy:     c.Healthy(),
+	}
+	if process.Command != nil && process.Command.Process != nil {
+		s.PID = process.Command.Process.Pid
+	}
+	c.mu.Lock()
+	if c.target != nil {
+		s.LastEvent = c.target.EventQueueStats().LastReceived
+	}
+	c.mu.Unlock()
+	if err := c.Err(); err != nil {
+		s.Err = err.Error()
+	}
+	return s
+}
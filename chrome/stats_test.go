@@ -0,0 +1,79 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"streaming_hdp/devtools"
+	"streaming_hdp/devtools/cdptest"
+)
+
+func TestStats(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	im := newFakeInstanceManager(s)
+	defer closeInstanceManager(t, im)
+	usedID, usedInstance := im.addFakeInstance(t)
+	deadID, deadInstance := im.addFakeInstance(t)
+	idleID, _ := im.addFakeInstance(t)
+	im.GetNewInstance("http://example.com/")
+	im.GetNewInstance("http://example.com/crash")
+	// Instances dying while in use are removed by their handler, which is not done yet.
+	deadInstance.die(errors.New("the tab crashed"))
+
+	// Handlers only reading events through subscriptions discard them, which still counts as activity.
+	usedInstance.DiscardEvents()
+	loaded, cancel := usedInstance.Subscribe("Page.loadEventFired")
+	defer cancel()
+	s.Emit(devtools.EventMessage{Method: "Page.loadEventFired", Params: devtools.Params{}})
+	<-loaded
+	im.launch = func() (*Instance, error) {
+		return nil, errors.New("chrome failed to start")
+	}
+	im.instancesMutex.Lock()
+	im.starting++
+	im.instancesMutex.Unlock()
+	if id := im.addInstance(); id != -1 {
+		t.Fatalf("addInstance() = %v, want -1", id)
+	}
+
+	stats := im.Stats()
+	if stats.Idle != 1 || stats.InUse != 2 || stats.Starting != 0 || stats.LaunchFailures != 1 {
+		t.Errorf("Stats() = %+v, want 1 idle, 2 in use and 1 launch failure", stats)
+	}
+	if len(stats.Instances) != 3 {
+		t.Fatalf("Stats() has %v instances, want 3", len(stats.Instances))
+	}
+	used, dead, idle := stats.Instances[0], stats.Instances[1], stats.Instances[2]
+	if used.ID != usedID || used.State != InstanceInUse || used.URL != "http://example.com/" {
+		t.Errorf("stats of the instance in use = %+v, want instance %v in use for http://example.com/", used, usedID)
+	}
+	if used.Port != s.Listener.Addr().(*net.TCPAddr).Port || used.Age <= 0 {
+		t.Errorf("stats of the instance in use = %+v, want the port of the fake chrome and a positive age", used)
+	}
+	if since := time.Since(used.LastEvent); since < 0 || since > time.Minute {
+		t.Errorf("LastEvent of the instance in use = %v, want the time of the event", used.LastEvent)
+	}
+	if idle.ID != idleID || idle.State != InstanceIdle || idle.URL != "" {
+		t.Errorf("stats of the idle instance = %+v, want instance %v idle", idle, idleID)
+	}
+	if dead.ID != deadID || dead.State != InstanceDead || dead.URL != "http://example.com/crash" || dead.Err != "the tab crashed" {
+		t.Errorf("stats of the dead instance = %+v, want instance %v dead", dead, deadID)
+	}
+}
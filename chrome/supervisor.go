@@ -0,0 +1,151 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"errors"
+	"fmt"
+
+	"streaming_hdp/devtools"
+)
+
+// ErrTerminated is the error of the instances terminated with DisconnectAndTerminate.
+var ErrTerminated = errors.New("the Chrome instance was terminated")
+
+// Dead returns a channel closed once this Chrome instance can no longer be used: Chrome
+// exited, its tab crashed or was closed, the connection to DevTools was lost, or the instance was
+// terminated. Err returns why.
+func (c *Instance) Dead() <-chan struct{} {
+	c.deadMu.Lock()
+	defer c.deadMu.Unlock()
+	if c.dead == nil {
+		c.dead = make(chan struct{})
+	}
+	return c.dead
+}
+
+// Err returns why this Chrome instance died, or nil while it is usable.
+func (c *Instance) Err() error {
+	c.deadMu.Lock()
+	defer c.deadMu.Unlock()
+	return c.deadErr
+}
+
+// markDead records why the instance died and closes Dead. Only the first reason is kept.
+// Returns whether the instance was alive.
+func (c *Instance) markDead(err error) bool {
+	c.deadMu.Lock()
+	defer c.deadMu.Unlock()
+	if c.deadErr != nil {
+		return false
+	}
+	if c.dead == nil {
+		c.dead = make(chan struct{})
+	}
+	c.deadErr = err
+	close(c.dead)
+	return true
+}
+
+// die marks the instance dead because of err, and terminates it to unblock the callers waiting
+// for its page: their events and methods fail.
+func (c *Instance) die(err error) {
+	if c.markDead(err) {
+		fmt.Printf("chrome instance %p died: %v\n", c, err)
+		c.DisconnectAndTerminate()
+	}
+}
+
+// waitProcess waits for the Chrome process to exit. Chrome exiting before being terminated kills the instance.
+func (c *Instance) waitProcess() {
+	err := c.Command.Wait()
+	close(c.exited)
+	if err == nil {
+		err = errors.New("Chrome exited")
+	} else {
+		err = fmt.Errorf("Chrome exited: %v", err)
+	}
+	c.die(err)
+}
+
+// watchTarget kills the instance when the tab crashes or is closed, or the connection to DevTools
+// is lost, until stop is closed.
+func (c *Instance) watchTarget(conn *devtools.Connection, target devtools.Target, stop <-chan struct{}) {
+	// Instances connected with ConnectRemote have no tab, only a connection to watch.
+	var crashed <-chan devtools.EventMessage
+	if target != nil {
+		var cancelCrashed func()
+		crashed, cancelCrashed = target.Subscribe("Inspector.targetCrashed")
+		defer cancelCrashed()
+		target.InvokeMethod("Inspector.enable", devtools.Params{})
+	}
+	destroyed, cancelDestroyed := conn.Subscribe("Target.targetDestroyed")
+	defer cancelDestroyed()
+	// Tabs connected to through their own endpoint are closed along with the connection.
+	targetID := ""
+	if session, ok := target.(*devtools.Session); ok {
+		targetID = session.TargetID
+		conn.InvokeMethod("Target.setDiscoverTargets", devtools.Params{"discover": true})
+	}
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-conn.Done():
+			c.die(fmt.Errorf("lost the connection to DevTools: %v", conn.Err()))
+			return
+		case _, ok := <-crashed:
+			select {
+			case <-stop:
+				// The tab was closed on purpose, ending the subscription.
+				return
+			default:
+			}
+			if ok {
+				c.die(errors.New("the tab crashed"))
+			} else {
+				c.die(errors.New("the tab was detached"))
+			}
+			return
+		case event, ok := <-destroyed:
+			if !ok {
+				destroyed = nil
+				continue
+			}
+			if id, _ := event.Params.String("targetId"); targetID != "" && id == targetID {
+				c.die(errors.New("the tab was closed"))
+				return
+			}
+		}
+	}
+}
+
+// supervise starts watching the tab of the instance, after stopping watching the previous one. c.mu must be held.
+func (c *Instance) supervise() {
+	if c.stopWatch != nil {
+		close(c.stopWatch)
+	}
+	c.stopWatch = make(chan struct{})
+	go c.watchTarget(c.devtoolsConn, c.target, c.stopWatch)
+}
+
+// stopSupervising stops watching the tab of the instance. c.mu must be held.
+func (c *Instance) stopSupervising() {
+	if c.stopWatch != nil {
+		close(c.stopWatch)
+		c.stopWatch = nil
+	}
+}
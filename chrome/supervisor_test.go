@@ -0,0 +1,134 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrome
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"streaming_hdp/devtools"
+	"streaming_hdp/devtools/cdptest"
+)
+
+// waitForDeath waits until the instance dies, and checks why.
+func waitForDeath(t *testing.T, chromeInstance *Instance, wantErr string) {
+	select {
+	case <-chromeInstance.Dead():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("the instance did not die")
+	}
+	if err := chromeInstance.Err(); err == nil || !strings.Contains(err.Error(), wantErr) {
+		t.Errorf("Err() = %v, want an error containing %q", err, wantErr)
+	}
+	if !chromeInstance.terminated() {
+		t.Errorf("the dead instance was not terminated")
+	}
+}
+
+func TestTabCrash(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	chromeInstance := newFakeInstance(t, s)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := s.WaitForCall(ctx, "Inspector.enable"); err != nil {
+		t.Fatalf("Inspector.enable was not invoked: %v", err)
+	}
+	if err := chromeInstance.Err(); err != nil {
+		t.Fatalf("Err() of a live instance = %v", err)
+	}
+
+	s.Emit(devtools.EventMessage{Method: "Inspector.targetCrashed", Params: devtools.Params{}})
+	waitForDeath(t, chromeInstance, "crashed")
+}
+
+func TestTabClosed(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	chromeInstance, err := fakePipeLaunch(s)()
+	if err != nil {
+		t.Fatalf("Failed to connect to the fake Chrome: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := s.WaitForCall(ctx, "Target.setDiscoverTargets"); err != nil {
+		t.Fatalf("Target.setDiscoverTargets was not invoked: %v", err)
+	}
+
+	// Other tabs may be closed.
+	s.Emit(devtools.EventMessage{Method: "Target.targetDestroyed", Params: devtools.Params{"targetId": "another-tab"}})
+	time.Sleep(10 * time.Millisecond)
+	if err := chromeInstance.Err(); err != nil {
+		t.Fatalf("closing another tab killed the instance: %v", err)
+	}
+	s.Emit(devtools.EventMessage{Method: "Target.targetDestroyed", Params: devtools.Params{"targetId": cdptest.PageTargetID}})
+	waitForDeath(t, chromeInstance, "closed")
+}
+
+func TestConnectionLost(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	chromeInstance := newFakeInstance(t, s)
+	s.Drop()
+	waitForDeath(t, chromeInstance, "connection")
+}
+
+func TestProcessExit(t *testing.T) {
+	cmd := exec.Command("sleep", "60")
+	if err := cmd.Start(); err != nil {
+		t.Skipf("failed to start a process: %v", err)
+	}
+	chromeInstance := &Instance{
+		Command: cmd,
+		exited:  make(chan struct{}),
+	}
+	go chromeInstance.waitProcess()
+	if ok, err := chromeInstance.started(); !ok || err != nil {
+		t.Fatalf("started() = %v, %v, want true, nil", ok, err)
+	}
+	cmd.Process.Kill()
+	waitForDeath(t, chromeInstance, "exited")
+	if ok, _ := chromeInstance.started(); ok {
+		t.Errorf("started() = true after the process exited")
+	}
+}
+
+func TestTerminateIsNotACrash(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	chromeInstance, err := fakePipeLaunch(s)()
+	if err != nil {
+		t.Fatalf("Failed to connect to the fake Chrome: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Resetting closes the tab on purpose.
+	if err := chromeInstance.Reset(ctx); err != nil {
+		t.Fatalf("Reset: %v", err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if err := chromeInstance.Err(); err != nil {
+		t.Fatalf("Reset killed the instance: %v", err)
+	}
+
+	chromeInstance.DisconnectAndTerminate()
+	if err := chromeInstance.Err(); err != ErrTerminated {
+		t.Errorf("Err() = %v, want %v", err, ErrTerminated)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cdp contains typed bindings for the Chrome Devtools Protocol domains used by the proxy.
+// The bindings are generated by cdpgen from the protocol definitions in devtools/protocol, and are
+// layered on top of devtools.Params, which remains available for anything not covered here.
+//
+// Commands are invoked through a value of the domain type wrapping a devtools.Connection or Session:
+//
+//	root, err := cdp.DOM{Caller: conn}.GetDocument(ctx, &cdp.DOMGetDocumentParams{Depth: cdp.Int(-1)})
+//
+// Events are decoded from a devtools.EventMessage with DecodeEvent.
+package cdp
+
+//go:generate go run streaming_hdp/devtools/cdpgen -protocol_dir ../protocol -out .
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"streaming_hdp/devtools"
+)
+
+// Caller invokes a method and returns its return value. It is implemented by
+// *devtools.Connection and *devtools.Session.
+type Caller interface {
+	Call(ctx context.Context, methodName string, params devtools.Params) (devtools.Params, error)
+}
+
+// invoke calls the method with the typed params, and decodes its return value into returns, unless it is nil.
+func invoke(ctx context.Context, c Caller, methodName string, params, returns interface{}) error {
+	p := devtools.Params{}
+	if err := convert(params, &p); err != nil {
+		return fmt.Errorf("encoding the parameters of %v: %v", methodName, err)
+	}
+	if p == nil {
+		// A nil pointer to the params struct encodes as null.
+		p = devtools.Params{}
+	}
+	result, err := c.Call(ctx, methodName, p)
+	if err != nil {
+		return err
+	}
+	if returns == nil {
+		return nil
+	}
+	if err := convert(result, returns); err != nil {
+		return fmt.Errorf("decoding the return value of %v: %v", methodName, err)
+	}
+	return nil
+}
+
+// DecodeEvent decodes the parameters of the event into v, which should be a pointer to the
+// type generated for the event, e.g. *DOMChildNodeInsertedEvent.
+func DecodeEvent(event devtools.EventMessage, v interface{}) error {
+	if err := convert(event.Params, v); err != nil {
+		return fmt.Errorf("decoding %v: %v", event.Method, err)
+	}
+	return nil
+}
+
+// convert converts from into to by going through their JSON encoding.
+func convert(from, to interface{}) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, to)
+}
+
+// Int returns a pointer to v, for setting optional integer parameters.
+func Int(v int) *int {
+	return &v
+}
+
+// Float returns a pointer to v, for setting optional number parameters.
+func Float(v float64) *float64 {
+	return &v
+}
+
+// Bool returns a pointer to v, for setting optional boolean parameters.
+func Bool(v bool) *bool {
+	return &v
+}
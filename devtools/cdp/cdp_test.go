@@ -0,0 +1,99 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdp
+
+import (
+	"context"
+	"testing"
+
+	"streaming_hdp/devtools"
+)
+
+// fakeCaller records the last method called and returns a canned result.
+type fakeCaller struct {
+	method string
+	params devtools.Params
+	result devtools.Params
+}
+
+func (f *fakeCaller) Call(_ context.Context, methodName string, params devtools.Params) (devtools.Params, error) {
+	f.method = methodName
+	f.params = params
+	return f.result, nil
+}
+
+func TestTypedCommand(t *testing.T) {
+	caller := &fakeCaller{result: devtools.Params{
+		"root": map[string]interface{}{
+			"nodeId":    float64(1),
+			"nodeName":  "#document",
+			"nodeType":  float64(9),
+			"localName": "",
+			"nodeValue": "",
+			"children": []interface{}{
+				map[string]interface{}{"nodeId": float64(2), "nodeName": "HTML"},
+			},
+		},
+	}}
+	returns, err := DOM{Caller: caller}.GetDocument(context.Background(), &DOMGetDocumentParams{Depth: Int(-1)})
+	if err != nil {
+		t.Fatalf("GetDocument: %v", err)
+	}
+	if caller.method != CommandDOMGetDocument {
+		t.Errorf("method got: %v, want: %v", caller.method, CommandDOMGetDocument)
+	}
+	if depth, ok := caller.params.Int("depth"); !ok || depth != -1 {
+		t.Errorf("depth got: (%v, %v), want: (-1, true)", depth, ok)
+	}
+	if _, ok := caller.params["pierce"]; ok {
+		t.Errorf("omitted optional parameter pierce should not be sent")
+	}
+	if returns.Root.NodeID != 1 || len(returns.Root.Children) != 1 || returns.Root.Children[0].NodeName != "HTML" {
+		t.Errorf("GetDocument returned %+v", returns.Root)
+	}
+}
+
+func TestCommandWithoutParams(t *testing.T) {
+	caller := &fakeCaller{}
+	if err := (Page{Caller: caller}).Enable(context.Background(), nil); err != nil {
+		t.Fatalf("Enable: %v", err)
+	}
+	if caller.params == nil {
+		t.Errorf("params should be sent as an empty object, not null")
+	}
+}
+
+func TestDecodeEvent(t *testing.T) {
+	event := devtools.EventMessage{
+		Method: EventDOMChildNodeInserted,
+		Params: devtools.Params{
+			"parentNodeId":   float64(3),
+			"previousNodeId": float64(4),
+			"node":           map[string]interface{}{"nodeId": float64(5), "nodeName": "DIV"},
+		},
+	}
+	var inserted DOMChildNodeInsertedEvent
+	if err := DecodeEvent(event, &inserted); err != nil {
+		t.Fatalf("DecodeEvent: %v", err)
+	}
+	if inserted.ParentNodeID != 3 || inserted.Node == nil || inserted.Node.NodeID != 5 {
+		t.Errorf("DecodeEvent got: %+v", inserted)
+	}
+
+	event.Params["parentNodeId"] = "not a number"
+	if err := DecodeEvent(event, &inserted); err == nil {
+		t.Errorf("DecodeEvent should fail on a malformed event instead of panicking")
+	}
+}
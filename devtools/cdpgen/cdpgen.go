@@ -0,0 +1,537 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Cdpgen generates the typed Chrome Devtools Protocol bindings of package cdp from
+// the protocol definitions in devtools/protocol.
+// Usage, from devtools/cdp:
+// go generate
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+var (
+	protocolDir = flag.String("protocol_dir", "../protocol", "The directory holding browser_protocol.json and js_protocol.json.")
+	outDir      = flag.String("out", ".", "The directory to write the generated files to.")
+	pkg         = flag.String("package", "cdp", "The name of the generated package.")
+	domainList  = flag.String("domains", "DOM,Page,Network,Emulation,Runtime,CSS,Target", "Comma-separated list of the domains to generate commands and events for.")
+)
+
+const header = `// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by cdpgen. DO NOT EDIT.
+
+`
+
+// protocol is the root of a protocol definition file.
+type protocol struct {
+	Domains []*domain `json:"domains"`
+}
+
+// domain describes the types, commands and events of a protocol domain.
+type domain struct {
+	Domain       string     `json:"domain"`
+	Description  string     `json:"description"`
+	Deprecated   bool       `json:"deprecated"`
+	Dependencies []string   `json:"dependencies"`
+	Types        []*typeDef `json:"types"`
+	Commands     []*command `json:"commands"`
+	Events       []*command `json:"events"`
+}
+
+// typeDef describes a named type, a property of an object, or a parameter or return value.
+type typeDef struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Type        string     `json:"type"`
+	Ref         string     `json:"$ref"`
+	Enum        []string   `json:"enum"`
+	Items       *typeDef   `json:"items"`
+	Properties  []*typeDef `json:"properties"`
+	Optional    bool       `json:"optional"`
+	Deprecated  bool       `json:"deprecated"`
+}
+
+// command describes a command or an event.
+type command struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Deprecated  bool       `json:"deprecated"`
+	Parameters  []*typeDef `json:"parameters"`
+	Returns     []*typeDef `json:"returns"`
+}
+
+// generator holds the merged protocol and the types that have to be generated.
+type generator struct {
+	domains  map[string]*domain
+	types    map[string]*typeDef // Keyed by qualified ID, e.g. "DOM.NodeId".
+	selected map[string]bool     // The domains whose commands and events are generated.
+	needed   map[string]bool     // The qualified IDs of the types to generate.
+}
+
+func main() {
+	flag.Parse()
+
+	g := &generator{
+		domains:  make(map[string]*domain),
+		types:    make(map[string]*typeDef),
+		selected: make(map[string]bool),
+		needed:   make(map[string]bool),
+	}
+	for _, file := range []string{"browser_protocol.json", "js_protocol.json"} {
+		if err := g.load(filepath.Join(*protocolDir, file)); err != nil {
+			log.Fatalf("failed to load %v: %v", file, err)
+		}
+	}
+	for _, name := range strings.Split(*domainList, ",") {
+		if _, ok := g.domains[name]; !ok {
+			log.Fatalf("unknown domain: %v", name)
+		}
+		g.selected[name] = true
+	}
+	g.resolve()
+
+	files, err := g.generate()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, src := range files {
+		if err := ioutil.WriteFile(filepath.Join(*outDir, name), src, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// load adds the domains of the protocol definition file.
+func (g *generator) load(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var p protocol
+	if err := json.Unmarshal(data, &p); err != nil {
+		return err
+	}
+	for _, d := range p.Domains {
+		g.domains[d.Domain] = d
+		for _, t := range d.Types {
+			g.types[d.Domain+"."+t.ID] = t
+		}
+	}
+	return nil
+}
+
+// qualify returns the qualified ID of a reference made from the domain.
+func qualify(domainName, ref string) string {
+	if strings.Contains(ref, ".") {
+		return ref
+	}
+	return domainName + "." + ref
+}
+
+// resolve computes the types to generate: all types of the selected domains and, transitively,
+// the types of other domains they refer to.
+func (g *generator) resolve() {
+	var visit func(domainName string, t *typeDef)
+	visit = func(domainName string, t *typeDef) {
+		if t == nil {
+			return
+		}
+		if t.Ref != "" {
+			id := qualify(domainName, t.Ref)
+			if g.needed[id] {
+				return
+			}
+			g.needed[id] = true
+			visit(strings.SplitN(id, ".", 2)[0], g.types[id])
+			return
+		}
+		visit(domainName, t.Items)
+		for _, p := range t.Properties {
+			visit(domainName, p)
+		}
+	}
+	for name := range g.selected {
+		d := g.domains[name]
+		for _, t := range d.Types {
+			g.needed[name+"."+t.ID] = true
+			visit(name, t)
+		}
+		for _, c := range append(append([]*command{}, d.Commands...), d.Events...) {
+			for _, p := range append(append([]*typeDef{}, c.Parameters...), c.Returns...) {
+				visit(name, p)
+			}
+		}
+	}
+}
+
+// generate returns the formatted source of each generated file, keyed by file name.
+func (g *generator) generate() (map[string][]byte, error) {
+	var names []string
+	for name := range g.domains {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	files := make(map[string][]byte)
+	declared := make(map[string]string) // Go identifier to what declared it, to detect collisions.
+	for _, name := range names {
+		w := &writer{g: g, domain: g.domains[name], declared: declared}
+		if !w.write() {
+			continue
+		}
+		if w.err != nil {
+			return nil, w.err
+		}
+		src, err := format.Source(w.buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("formatting the %v domain: %v", name, err)
+		}
+		files[strings.ToLower(name)+".go"] = src
+	}
+	return files, nil
+}
+
+// writer writes the file of one domain.
+type writer struct {
+	g        *generator
+	domain   *domain
+	buf      bytes.Buffer
+	declared map[string]string
+	err      error
+}
+
+// printf writes formatted output to the file.
+func (w *writer) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&w.buf, format, args...)
+}
+
+// declare records the Go identifier, and fails if it is already declared.
+func (w *writer) declare(ident, what string) {
+	if other, ok := w.declared[ident]; ok && w.err == nil {
+		w.err = fmt.Errorf("%v and %v both generate the identifier %v", other, what, ident)
+	}
+	w.declared[ident] = what
+}
+
+// comment writes the description as a doc comment, starting with the prefix.
+func (w *writer) comment(indent, prefix, description string, deprecated bool) {
+	text := strings.TrimSpace(prefix + " " + description)
+	if text != "" {
+		for _, line := range strings.Split(text, "\n") {
+			w.printf("%s// %s\n", indent, strings.TrimRightFunc(line, unicode.IsSpace))
+		}
+	}
+	if deprecated {
+		if text != "" {
+			w.printf("%s//\n", indent)
+		}
+		w.printf("%s// Deprecated: deprecated by the protocol.\n", indent)
+	}
+}
+
+// write writes the file of the domain. Returns false if nothing has to be generated for the domain.
+func (w *writer) write() bool {
+	d := w.domain
+	var types []*typeDef
+	for _, t := range d.Types {
+		if w.g.needed[d.Domain+"."+t.ID] {
+			types = append(types, t)
+		}
+	}
+	selected := w.g.selected[d.Domain]
+	if !selected && len(types) == 0 {
+		return false
+	}
+
+	w.printf("%spackage %s\n\n", header, *pkg)
+	if selected && len(d.Commands) > 0 {
+		w.printf("import \"context\"\n\n")
+	}
+
+	for _, t := range types {
+		w.writeType(t)
+	}
+	if !selected {
+		return true
+	}
+
+	if len(d.Commands) > 0 {
+		w.declare(d.Domain, "the "+d.Domain+" domain")
+		w.comment("", d.Domain+" invokes the commands of the "+d.Domain+" domain.", d.Description, d.Deprecated)
+		w.printf("type %s struct {\n\tCaller\n}\n\n", d.Domain)
+		w.printf("// The names of the commands of the %s domain.\nconst (\n", d.Domain)
+		for _, c := range d.Commands {
+			ident := "Command" + d.Domain + exportName(c.Name)
+			w.declare(ident, d.Domain+"."+c.Name)
+			w.printf("\t%s = %q\n", ident, d.Domain+"."+c.Name)
+		}
+		w.printf(")\n\n")
+		for _, c := range d.Commands {
+			w.writeCommand(c)
+		}
+	}
+
+	if len(d.Events) > 0 {
+		w.printf("// The names of the events of the %s domain.\nconst (\n", d.Domain)
+		for _, e := range d.Events {
+			ident := "Event" + d.Domain + exportName(e.Name)
+			w.declare(ident, d.Domain+"."+e.Name)
+			w.printf("\t%s = %q\n", ident, d.Domain+"."+e.Name)
+		}
+		w.printf(")\n\n")
+		for _, e := range d.Events {
+			ident := d.Domain + exportName(e.Name) + "Event"
+			w.declare(ident, "event "+d.Domain+"."+e.Name)
+			w.comment("", ident+" holds the parameters of the "+d.Domain+"."+e.Name+" event.", e.Description, e.Deprecated)
+			w.writeStruct(ident, e.Parameters)
+		}
+	}
+	return true
+}
+
+// writeType writes the declaration of a named type.
+func (w *writer) writeType(t *typeDef) {
+	ident := w.domain.Domain + exportName(t.ID)
+	w.declare(ident, "type "+w.domain.Domain+"."+t.ID)
+	w.comment("", ident+" is "+w.domain.Domain+"."+t.ID+".", t.Description, t.Deprecated)
+	switch {
+	case t.Type == "object" && len(t.Properties) > 0:
+		w.writeStruct(ident, t.Properties)
+		return
+	case t.Type == "object":
+		w.printf("type %s map[string]interface{}\n\n", ident)
+	default:
+		w.printf("type %s %s\n\n", ident, w.goType(&typeDef{Type: t.Type, Items: t.Items}))
+	}
+	if len(t.Enum) > 0 {
+		w.printf("// Values of %s.\nconst (\n", ident)
+		seen := make(map[string]bool)
+		for _, v := range t.Enum {
+			valueIdent := ident + exportName(v)
+			if seen[valueIdent] {
+				continue
+			}
+			seen[valueIdent] = true
+			w.declare(valueIdent, "value "+v+" of "+ident)
+			w.printf("\t%s %s = %q\n", valueIdent, ident, v)
+		}
+		w.printf(")\n\n")
+	}
+}
+
+// writeStruct writes a struct with a field for each of the properties.
+func (w *writer) writeStruct(ident string, properties []*typeDef) {
+	w.printf("type %s struct {\n", ident)
+	for _, p := range properties {
+		if p.Description != "" || p.Deprecated {
+			w.comment("\t", "", p.Description, p.Deprecated)
+		}
+		tag := p.Name
+		if p.Optional {
+			tag += ",omitempty"
+		}
+		w.printf("\t%s %s `json:%q`\n", exportName(p.Name), w.goType(p), tag)
+	}
+	w.printf("}\n\n")
+}
+
+// writeCommand writes the parameter and return types of the command, and the method invoking it.
+func (w *writer) writeCommand(c *command) {
+	d := w.domain.Domain
+	name := exportName(c.Name)
+	args := "ctx context.Context"
+	params := "nil"
+	if len(c.Parameters) > 0 {
+		ident := d + name + "Params"
+		w.declare(ident, "parameters of "+d+"."+c.Name)
+		w.printf("// %s holds the parameters of %s.%s.\n", ident, d, c.Name)
+		w.writeStruct(ident, c.Parameters)
+		args += ", params *" + ident
+		params = "params"
+	}
+	returns := ""
+	if len(c.Returns) > 0 {
+		returns = d + name + "Returns"
+		w.declare(returns, "return values of "+d+"."+c.Name)
+		w.printf("// %s holds the return values of %s.%s.\n", returns, d, c.Name)
+		w.writeStruct(returns, c.Returns)
+	}
+
+	w.comment("", name+" invokes "+d+"."+c.Name+".", c.Description, c.Deprecated)
+	command := "Command" + d + name
+	if returns == "" {
+		w.printf("func (d %s) %s(%s) error {\n", d, name, args)
+		w.printf("\treturn invoke(ctx, d.Caller, %s, %s, nil)\n}\n\n", command, params)
+		return
+	}
+	w.printf("func (d %s) %s(%s) (*%s, error) {\n", d, name, args, returns)
+	w.printf("\tvar returns %s\n", returns)
+	w.printf("\tif err := invoke(ctx, d.Caller, %s, %s, &returns); err != nil {\n\t\treturn nil, err\n\t}\n", command, params)
+	w.printf("\treturn &returns, nil\n}\n\n")
+}
+
+// goType returns the Go type of a property, parameter or return value.
+func (w *writer) goType(t *typeDef) string {
+	if t.Ref != "" {
+		id := qualify(w.domain.Domain, t.Ref)
+		parts := strings.SplitN(id, ".", 2)
+		ident := parts[0] + exportName(parts[1])
+		target := w.g.types[id]
+		if target == nil {
+			if w.err == nil {
+				w.err = fmt.Errorf("unknown type %v referenced from the %v domain", id, w.domain.Domain)
+			}
+			return "interface{}"
+		}
+		if target.Type == "object" && len(target.Properties) > 0 {
+			return "*" + ident
+		}
+		if t.Optional && isScalar(target.Type) && len(target.Enum) == 0 {
+			return "*" + ident
+		}
+		return ident
+	}
+
+	var goType string
+	switch t.Type {
+	case "integer":
+		goType = "int"
+	case "number":
+		goType = "float64"
+	case "boolean":
+		goType = "bool"
+	case "string", "binary":
+		return "string"
+	case "object":
+		return "map[string]interface{}"
+	case "array":
+		item := w.goType(t.Items)
+		return "[]" + strings.TrimPrefix(item, "*")
+	default:
+		return "interface{}"
+	}
+	if t.Optional {
+		// Distinguishes an omitted parameter from its zero value.
+		return "*" + goType
+	}
+	return goType
+}
+
+// isScalar returns whether values of the protocol type have a zero value that differs from being omitted.
+func isScalar(protocolType string) bool {
+	return protocolType == "integer" || protocolType == "number" || protocolType == "boolean"
+}
+
+// initialisms maps lower-case words to the spelling Go uses for them in identifiers.
+var initialisms = map[string]string{
+	"api":   "API",
+	"css":   "CSS",
+	"dom":   "DOM",
+	"html":  "HTML",
+	"http":  "HTTP",
+	"https": "HTTPS",
+	"id":    "ID",
+	"ids":   "IDs",
+	"ip":    "IP",
+	"js":    "JS",
+	"json":  "JSON",
+	"ui":    "UI",
+	"uri":   "URI",
+	"url":   "URL",
+	"urls":  "URLs",
+	"uuid":  "UUID",
+	"xml":   "XML",
+}
+
+// exportName converts a protocol name, e.g. "backendNodeId" or "x-large", into an exported Go identifier.
+func exportName(name string) string {
+	var out strings.Builder
+	for _, word := range splitWords(name) {
+		if initialism, ok := initialisms[strings.ToLower(word)]; ok {
+			out.WriteString(initialism)
+			continue
+		}
+		runes := []rune(word)
+		out.WriteRune(unicode.ToUpper(runes[0]))
+		out.WriteString(string(runes[1:]))
+	}
+	return out.String()
+}
+
+// splitWords splits a camel-cased, dashed or underscored name into words. A run of upper-case
+// letters is a single word, e.g. "getOuterHTML" is split into "get", "Outer" and "HTML".
+func splitWords(name string) []string {
+	var words []string
+	runes := []rune(name)
+	start := -1
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start >= 0 {
+				words = append(words, string(runes[start:i]))
+			}
+			start = -1
+			continue
+		}
+		if start < 0 {
+			start = i
+			continue
+		}
+		prev := runes[i-1]
+		lowerToUpper := unicode.IsUpper(r) && !unicode.IsUpper(prev)
+		endOfRun := unicode.IsUpper(prev) && unicode.IsUpper(r) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+		if lowerToUpper || endOfRun {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+func init() {
+	log.SetFlags(0)
+	log.SetPrefix("cdpgen: ")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: cdpgen [flags]\n")
+		flag.PrintDefaults()
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import "testing"
+
+func TestExportName(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"nodeId", "NodeID"},
+		{"backendNodeIds", "BackendNodeIDs"},
+		{"getOuterHTML", "GetOuterHTML"},
+		{"DOMStorage", "DOMStorage"},
+		{"requestWillBeSent", "RequestWillBeSent"},
+		{"x-large", "XLarge"},
+		{"pauseIfNetworkFetchesPending", "PauseIfNetworkFetchesPending"},
+		{"documentURL", "DocumentURL"},
+		{"Identifier", "Identifier"},
+	}
+	for _, test := range tests {
+		if got := exportName(test.name); got != test.want {
+			t.Errorf("exportName(%q) got: %v, want: %v", test.name, got, test.want)
+		}
+	}
+}
+
+// Tests that the protocol checked into the repository generates without identifier collisions.
+func TestGenerate(t *testing.T) {
+	g := &generator{
+		domains:  make(map[string]*domain),
+		types:    make(map[string]*typeDef),
+		selected: map[string]bool{"DOM": true, "Page": true, "Network": true, "Emulation": true, "Runtime": true, "CSS": true, "Target": true},
+		needed:   make(map[string]bool),
+	}
+	for _, file := range []string{"../protocol/browser_protocol.json", "../protocol/js_protocol.json"} {
+		if err := g.load(file); err != nil {
+			t.Fatalf("load(%v): %v", file, err)
+		}
+	}
+	g.resolve()
+	files, err := g.generate()
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if _, ok := files["dom.go"]; !ok {
+		t.Errorf("dom.go was not generated")
+	}
+}
@@ -0,0 +1,463 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cdptest implements a fake Chrome that speaks the Chrome Devtools Protocol, for
+// testing code built on package devtools without a Chrome binary.
+//
+// The Server serves /json, /json/version and their websocket endpoints, and the pipes of
+// --remote-debugging-pipe with ServePipe. Tests script the response to each method with
+// Handle, fire arbitrary sequences of events with Emit, and assert on the methods the code
+// under test invoked with Calls and WaitForCall.
+package cdptest
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"streaming_hdp/devtools"
+)
+
+const (
+	// PageTargetID is the ID of the tab listed by /json.
+	PageTargetID = "page-1"
+
+	// The paths of the websocket endpoints.
+	pagePath    = "/devtools/page/" + PageTargetID
+	browserPath = "/devtools/browser/fake"
+)
+
+// Call is a method invoked on the Server.
+type Call struct {
+	ID        int
+	SessionID string
+	Method    string
+	Params    devtools.Params
+}
+
+// HandlerFunc returns the result of a method call. Returning a *devtools.ProtocolError sends it
+// as the error object of the response; any other error is sent with the generic server error code.
+type HandlerFunc func(call Call) (devtools.Params, error)
+
+// Server is a fake Chrome.
+type Server struct {
+	*httptest.Server
+
+	upgrader websocket.Upgrader
+
+	mu            sync.Mutex // Protects the following fields.
+	handlers      map[string]HandlerFunc
+	calls         []Call
+	callAdded     chan struct{} // Closed and replaced whenever a call is recorded.
+	conns         map[*conn]bool
+	connAdded     chan struct{} // Closed and replaced whenever a connection is opened.
+	nextTargetID  int
+	nextContextID int
+	unresponsive  bool
+
+	// Strict makes methods without a handler fail like unknown methods do in Chrome.
+	// Otherwise, they succeed with an empty result, as most enable and set methods do.
+	Strict bool
+}
+
+// conn is a connection to the Server, over a websocket or pipes.
+type conn struct {
+	mu        sync.Mutex // Serializes writes.
+	writeJSON func(msg interface{}) error
+	close     func() error
+}
+
+// write sends the message on the connection.
+func (c *conn) write(msg interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.writeJSON(msg)
+}
+
+// message is a method received by the Server.
+type message struct {
+	ID        int             `json:"id"`
+	SessionID string          `json:"sessionId"`
+	Method    string          `json:"method"`
+	Params    devtools.Params `json:"params"`
+}
+
+// NewServer starts a Server. The caller should call Close when finished, to shut it down.
+// The Server handles the Target methods used by devtools sessions out of the box.
+func NewServer() *Server {
+	s := &Server{
+		handlers:  make(map[string]HandlerFunc),
+		callAdded: make(chan struct{}),
+		conns:     make(map[*conn]bool),
+		connAdded: make(chan struct{}),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json", s.serveTargets)
+	mux.HandleFunc("/json/list", s.serveTargets)
+	mux.HandleFunc("/json/version", s.serveVersion)
+	mux.HandleFunc(pagePath, s.serveWebSocket)
+	mux.HandleFunc(browserPath, s.serveWebSocket)
+	s.Server = httptest.NewServer(mux)
+
+	s.Handle("Target.getTargets", func(Call) (devtools.Params, error) {
+		return devtools.Params{"targetInfos": []devtools.Params{{
+			"targetId": PageTargetID,
+			"type":     devtools.TabType,
+			"title":    "about:blank",
+			"url":      "about:blank",
+		}}}, nil
+	})
+	s.Handle("Target.createBrowserContext", func(Call) (devtools.Params, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.nextContextID++
+		return devtools.Params{"browserContextId": fmt.Sprintf("context-%d", s.nextContextID)}, nil
+	})
+	s.Handle("Target.createTarget", func(Call) (devtools.Params, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.nextTargetID++
+		return devtools.Params{"targetId": fmt.Sprintf("target-%d", s.nextTargetID)}, nil
+	})
+	s.Handle("Target.attachToTarget", func(call Call) (devtools.Params, error) {
+		targetID, _ := call.Params.String("targetId")
+		return devtools.Params{"sessionId": SessionID(targetID)}, nil
+	})
+	s.Handle("Target.closeTarget", func(call Call) (devtools.Params, error) {
+		targetID, _ := call.Params.String("targetId")
+		s.Emit(devtools.EventMessage{
+			Method: "Target.detachedFromTarget",
+			Params: devtools.Params{"sessionId": SessionID(targetID), "targetId": targetID},
+		})
+		return devtools.Params{"success": true}, nil
+	})
+	return s
+}
+
+// SessionID returns the session ID the Server assigns when attaching to the target.
+func SessionID(targetID string) string {
+	return "session-" + targetID
+}
+
+// HostPort returns the host:port the Server listens on, as passed to devtools.NewConnection.
+func (s *Server) HostPort() string {
+	return strings.TrimPrefix(s.URL, "http://")
+}
+
+// Close drops all connections and shuts down the Server.
+func (s *Server) Close() {
+	s.Drop()
+	s.Server.Close()
+}
+
+// Handle sets the handler for the method, replacing any previous one.
+func (s *Server) Handle(method string, h HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[method] = h
+}
+
+// HandleResult makes the method always return result.
+func (s *Server) HandleResult(method string, result devtools.Params) {
+	s.Handle(method, func(Call) (devtools.Params, error) {
+		return result, nil
+	})
+}
+
+// HandleError makes the method always fail with err.
+func (s *Server) HandleError(method string, err *devtools.ProtocolError) {
+	s.Handle(method, func(Call) (devtools.Params, error) {
+		return nil, err
+	})
+}
+
+// Emit sends the events, in order, on every open connection, waiting for a connection to be opened
+// if there is none. Events with a SessionID are only meaningful on browser connections, where they
+// are routed to that session.
+func (s *Server) Emit(events ...devtools.EventMessage) error {
+	s.mu.Lock()
+	for len(s.conns) == 0 {
+		connAdded := s.connAdded
+		s.mu.Unlock()
+		<-connAdded
+		s.mu.Lock()
+	}
+	conns := make([]*conn, 0, len(s.conns))
+	for c := range s.conns {
+		conns = append(conns, c)
+	}
+	s.mu.Unlock()
+
+	for _, event := range events {
+		msg := map[string]interface{}{"method": event.Method, "params": event.Params}
+		if event.Params == nil {
+			msg["params"] = devtools.Params{}
+		}
+		if event.SessionID != "" {
+			msg["sessionId"] = event.SessionID
+		}
+		for _, c := range conns {
+			if err := c.write(msg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Drop closes every open connection without a close message, like a crashed Chrome would.
+func (s *Server) Drop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for c := range s.conns {
+		c.close()
+		delete(s.conns, c)
+	}
+}
+
+// SetUnresponsive makes the Server stop answering methods and websocket pings, like a wedged
+// Chrome would, or answer them again. Methods received while unresponsive are never answered.
+func (s *Server) SetUnresponsive(unresponsive bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unresponsive = unresponsive
+}
+
+// isUnresponsive returns whether the Server answers methods and pings.
+func (s *Server) isUnresponsive() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.unresponsive
+}
+
+// Calls returns the methods invoked so far, in the order they were received.
+func (s *Server) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Call(nil), s.calls...)
+}
+
+// CallsTo returns the calls to the method, in the order they were received.
+func (s *Server) CallsTo(method string) []Call {
+	var calls []Call
+	for _, call := range s.Calls() {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// WaitForCall blocks until the method has been invoked, and returns its first call.
+func (s *Server) WaitForCall(ctx context.Context, method string) (Call, error) {
+	for {
+		s.mu.Lock()
+		for _, call := range s.calls {
+			if call.Method == method {
+				s.mu.Unlock()
+				return call, nil
+			}
+		}
+		callAdded := s.callAdded
+		s.mu.Unlock()
+
+		select {
+		case <-callAdded:
+		case <-ctx.Done():
+			return Call{}, fmt.Errorf("waiting for %v: %v", method, ctx.Err())
+		}
+	}
+}
+
+// serveTargets serves /json, which lists the single tab of the Server.
+func (s *Server) serveTargets(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode([]*devtools.Page{{
+		ID:                   PageTargetID,
+		Title:                "about:blank",
+		Type:                 devtools.TabType,
+		URL:                  "about:blank",
+		WebSocketDebuggerURL: s.webSocketURL(r, pagePath),
+	}})
+}
+
+// serveVersion serves /json/version, which holds the browser-level endpoint.
+func (s *Server) serveVersion(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(&devtools.BrowserVersion{
+		Browser:              "FakeChrome/1.0",
+		ProtocolVersion:      "1.3",
+		WebSocketDebuggerURL: s.webSocketURL(r, browserPath),
+	})
+}
+
+// webSocketURL returns the URL of the websocket endpoint at path.
+func (s *Server) webSocketURL(r *http.Request, path string) string {
+	return "ws://" + r.Host + path
+}
+
+// serveWebSocket answers the methods received on a websocket connection.
+func (s *Server) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	sock, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	// Like Chrome, drop the connection without replying to the close message.
+	sock.SetCloseHandler(func(int, string) error { return nil })
+	sock.SetPingHandler(func(payload string) error {
+		if s.isUnresponsive() {
+			return nil
+		}
+		err := sock.WriteControl(websocket.PongMessage, []byte(payload), time.Now().Add(time.Second))
+		if err == websocket.ErrCloseSent {
+			return nil
+		}
+		return err
+	})
+	c := &conn{writeJSON: sock.WriteJSON, close: sock.Close}
+	s.serve(c, func(msg *message) error {
+		return sock.ReadJSON(msg)
+	})
+}
+
+// ServePipe answers the methods read from r on w, as Chrome started with --remote-debugging-pipe
+// does: each message is JSON followed by a NUL byte. ServePipe returns once r or w fails; w is
+// then closed if it is an io.Closer.
+func (s *Server) ServePipe(r io.Reader, w io.Writer) {
+	reader := bufio.NewReader(r)
+	c := &conn{
+		writeJSON: func(msg interface{}) error {
+			data, err := json.Marshal(msg)
+			if err != nil {
+				return err
+			}
+			_, err = w.Write(append(data, 0))
+			return err
+		},
+		close: func() error {
+			if closer, ok := w.(io.Closer); ok {
+				return closer.Close()
+			}
+			return nil
+		},
+	}
+	s.serve(c, func(msg *message) error {
+		data, err := reader.ReadBytes(0)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(data[:len(data)-1], msg)
+	})
+}
+
+// serve answers the methods read from the connection, until read fails.
+func (s *Server) serve(c *conn, read func(msg *message) error) {
+	s.mu.Lock()
+	s.conns[c] = true
+	close(s.connAdded)
+	s.connAdded = make(chan struct{})
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, c)
+		s.mu.Unlock()
+		c.close()
+	}()
+
+	for {
+		var msg message
+		if err := read(&msg); err != nil {
+			return
+		}
+		call := Call{ID: msg.ID, SessionID: msg.SessionID, Method: msg.Method, Params: msg.Params}
+
+		s.mu.Lock()
+		s.calls = append(s.calls, call)
+		close(s.callAdded)
+		s.callAdded = make(chan struct{})
+		h, ok := s.handlers[call.Method]
+		strict := s.Strict
+		unresponsive := s.unresponsive
+		s.mu.Unlock()
+		if unresponsive {
+			continue
+		}
+
+		resp := map[string]interface{}{"id": call.ID}
+		if call.SessionID != "" {
+			resp["sessionId"] = call.SessionID
+		}
+		var result devtools.Params
+		var err error
+		switch {
+		case ok:
+			result, err = h(call)
+		case strict:
+			err = &devtools.ProtocolError{Code: -32601, Message: "'" + call.Method + "' wasn't found"}
+		}
+		switch err := err.(type) {
+		case nil:
+			if result == nil {
+				result = devtools.Params{}
+			}
+			resp["result"] = result
+		case *devtools.ProtocolError:
+			resp["error"] = map[string]interface{}{"code": err.Code, "message": err.Message, "data": err.Data}
+		default:
+			resp["error"] = map[string]interface{}{"code": -32000, "message": err.Error()}
+		}
+		if err := c.write(resp); err != nil {
+			return
+		}
+	}
+}
+
+// ReadEvents reads events recorded one JSON object per line, each with a "method", optional
+// "params" and optional "sessionId", e.g. a storm of DOM.childNodeInserted events to Emit.
+func ReadEvents(r io.Reader) ([]devtools.EventMessage, error) {
+	var events []devtools.EventMessage
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 64*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var event devtools.EventMessage
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			return nil, fmt.Errorf("reading event %d: %v", len(events)+1, err)
+		}
+		events = append(events, event)
+	}
+	return events, scanner.Err()
+}
+
+// ChromeBinary is the Chrome binary launched by the tests that need a real Chrome.
+const ChromeBinary = "google-chrome"
+
+// RequireChrome skips the test if ChromeBinary is not installed.
+func RequireChrome(t testing.TB) {
+	if _, err := exec.LookPath(ChromeBinary); err != nil {
+		t.Skipf("%v is not installed: %v", ChromeBinary, err)
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdptest
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"streaming_hdp/devtools"
+)
+
+// connect connects to the tab of the server.
+func connect(t *testing.T, s *Server) *devtools.Connection {
+	conn, err := devtools.NewConnection(s.HostPort())
+	if err != nil {
+		t.Fatalf("NewConnection() failed: %v", err)
+	}
+	return conn
+}
+
+func TestReceivingEvents(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	s.Handle("Page.navigate", func(call Call) (devtools.Params, error) {
+		url, _ := call.Params.String("url")
+		go s.Emit(
+			devtools.EventMessage{Method: "Page.frameNavigated", Params: devtools.Params{"frame": devtools.Params{"url": url}}},
+			devtools.EventMessage{Method: "Page.loadEventFired", Params: devtools.Params{"timestamp": 1.5}},
+		)
+		return devtools.Params{"frameId": "frame-1"}, nil
+	})
+	conn := connect(t, s)
+	defer conn.Close()
+
+	conn.InvokeMethod("Page.enable", devtools.Params{})
+	conn.InvokeMethod("Page.navigate", devtools.Params{"url": "http://example.com/"})
+
+	var methods []string
+	for len(methods) < 2 {
+		event, err := conn.NextEvent()
+		if err != nil {
+			t.Fatalf("NextEvent() failed: %v", err)
+		}
+		methods = append(methods, event.Method)
+	}
+	if methods[0] != "Page.frameNavigated" || methods[1] != "Page.loadEventFired" {
+		t.Errorf("events = %v, want [Page.frameNavigated Page.loadEventFired]", methods)
+	}
+
+	calls := s.Calls()
+	if len(calls) != 2 || calls[0].Method != "Page.enable" || calls[1].Method != "Page.navigate" {
+		t.Fatalf("calls = %v, want Page.enable then Page.navigate", calls)
+	}
+	if url, _ := calls[1].Params.String("url"); url != "http://example.com/" {
+		t.Errorf("Page.navigate url = %q, want %q", url, "http://example.com/")
+	}
+}
+
+func TestInvokeMethodAndGetReturn(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	s.Strict = true
+	s.HandleResult("Runtime.evaluate", devtools.Params{"result": devtools.Params{"value": devtools.Params{"x": 42}}})
+	conn := connect(t, s)
+	defer conn.Close()
+
+	t.Run("Method_not_exists", func(t *testing.T) {
+		result := conn.InvokeMethodAndGetReturn("Random.MethodThatWillNeverExists", devtools.Params{})
+		if result.Type != devtools.ResultError {
+			t.Errorf("The result should be an error.\n")
+		}
+	})
+
+	t.Run("get_grandchild", func(t *testing.T) {
+		result := conn.InvokeMethodAndGetReturn("Runtime.evaluate", devtools.Params{
+			"expression":    `a={x: 42}; a`,
+			"returnByValue": true,
+		})
+		val, ok := result.Params.Int("result.value.x")
+		if !ok || val != 42 {
+			t.Fatalf("Int('result.value.x'): val,ok = (%v, %v), want (%v, %v)", val, ok, 42, true)
+		}
+	})
+
+	t.Run("protocol_error", func(t *testing.T) {
+		s.HandleError("DOM.getDocument", &devtools.ProtocolError{Code: -32000, Message: "DOM agent is not enabled"})
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err := conn.Call(ctx, "DOM.getDocument", devtools.Params{})
+		perr, ok := err.(*devtools.ProtocolError)
+		if !ok || perr.Code != -32000 || perr.Message != "DOM agent is not enabled" {
+			t.Errorf("Call() error = %#v, want the scripted *ProtocolError", err)
+		}
+	})
+}
+
+func TestEventStorm(t *testing.T) {
+	f, err := os.Open("testdata/child_node_inserted.jsonl")
+	if err != nil {
+		t.Fatalf("failed to open the recorded events: %v", err)
+	}
+	defer f.Close()
+	recorded, err := ReadEvents(f)
+	if err != nil {
+		t.Fatalf("ReadEvents() failed: %v", err)
+	}
+
+	// Replay the recording many times over, faster than it is consumed.
+	const repeat = 500
+	var events []devtools.EventMessage
+	for i := 0; i < repeat; i++ {
+		events = append(events, recorded...)
+	}
+
+	s := NewServer()
+	conn := connect(t, s)
+	inserted, cancel := conn.Subscribe("DOM.childNodeInserted")
+	defer cancel()
+	if err := s.Emit(events...); err != nil {
+		t.Fatalf("Emit() failed: %v", err)
+	}
+	// Dropping the connection must not lose the events already received.
+	s.Close()
+
+	count := 0
+	for range inserted {
+		count++
+	}
+	if want := 5 * repeat; count != want {
+		t.Errorf("received %v DOM.childNodeInserted events, want %v", count, want)
+	}
+
+	total := 0
+	for {
+		_, err := conn.NextEvent()
+		if err != nil {
+			if err == io.EOF {
+				t.Errorf("NextEvent() returned io.EOF after the connection was dropped, want the connection error")
+			}
+			break
+		}
+		total++
+	}
+	if total != len(events) {
+		t.Errorf("NextEvent() returned %v events, want %v", total, len(events))
+	}
+}
+
+func TestWaitForCall(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	conn := connect(t, s)
+	defer conn.Close()
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		conn.InvokeMethod("Emulation.setVirtualTimePolicy", devtools.Params{"policy": "pauseIfNetworkFetchesPending"})
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	call, err := s.WaitForCall(ctx, "Emulation.setVirtualTimePolicy")
+	if err != nil {
+		t.Fatalf("WaitForCall() failed: %v", err)
+	}
+	if policy, _ := call.Params.String("policy"); policy != "pauseIfNetworkFetchesPending" {
+		t.Errorf("policy = %q, want %q", policy, "pauseIfNetworkFetchesPending")
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := s.WaitForCall(ctx, "Page.navigate"); err == nil {
+		t.Errorf("WaitForCall() of a method never invoked succeeded")
+	}
+	if calls := s.CallsTo("Page.navigate"); len(calls) != 0 {
+		t.Errorf("CallsTo(Page.navigate) = %v, want none", calls)
+	}
+}
+
+func TestSessions(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	conn, err := devtools.NewBrowserConnection(s.HostPort())
+	if err != nil {
+		t.Fatalf("NewBrowserConnection() failed: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	browserContextID, err := conn.CreateBrowserContext(ctx)
+	if err != nil {
+		t.Fatalf("CreateBrowserContext() failed: %v", err)
+	}
+	session, err := conn.NewTab(ctx, browserContextID)
+	if err != nil {
+		t.Fatalf("NewTab() failed: %v", err)
+	}
+	if session.ID != SessionID(session.TargetID) {
+		t.Errorf("session ID = %q, want %q", session.ID, SessionID(session.TargetID))
+	}
+
+	if _, err := session.Call(ctx, "Page.enable", devtools.Params{}); err != nil {
+		t.Fatalf("Page.enable failed: %v", err)
+	}
+	calls := s.CallsTo("Page.enable")
+	if len(calls) != 1 || calls[0].SessionID != session.ID {
+		t.Errorf("CallsTo(Page.enable) = %v, want one call on session %v", calls, session.ID)
+	}
+
+	if err := session.Close(); err != nil {
+		t.Fatalf("Close() failed: %v", err)
+	}
+	select {
+	case <-session.Done():
+	case <-ctx.Done():
+		t.Fatalf("session did not end after closing its target")
+	}
+}
+
+func TestUnresponsive(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	conn := connect(t, s)
+	defer conn.Close()
+	// Probed with websocket pings only.
+	conn.StartKeepalive(devtools.KeepaliveOptions{Interval: 10 * time.Millisecond, MaxMissed: 2})
+
+	waitForHealth := func(healthy bool) {
+		deadline := time.Now().Add(5 * time.Second)
+		for conn.Healthy() != healthy || conn.Health().LastAnswer.IsZero() {
+			if time.Now().After(deadline) {
+				t.Fatalf("Health() = %+v, want healthy: %v", conn.Health(), healthy)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	waitForHealth(true)
+	s.SetUnresponsive(true)
+	waitForHealth(false)
+	s.SetUnresponsive(false)
+	waitForHealth(true)
+}
+
+func TestNextEventContext(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	conn := connect(t, s)
+	defer conn.Close()
+
+	s.Emit(devtools.EventMessage{Method: "Page.loadEventFired", Params: devtools.Params{}})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if event, err := conn.NextEventContext(ctx); err != nil || event.Method != "Page.loadEventFired" {
+		t.Errorf("NextEventContext() = %v, %v, want Page.loadEventFired", event.Method, err)
+	}
+	if _, err := conn.NextEventContext(ctx); err != context.DeadlineExceeded {
+		t.Errorf("NextEventContext() without events = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
@@ -17,14 +17,15 @@
 package devtools
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
-	"log"
 	"net/http"
+	"net/url"
 	"strings"
 	"sync"
-	"time"
 
 	"github.com/gorilla/websocket"
 )
@@ -32,17 +33,11 @@ import (
 const (
 	// TabType defines the value of Page.Type for Chrome tabs.
 	TabType = "page"
-
-	// The error code of a websocket.CloseError that is expected when the socket has begun the process of closing.
-	expectedCloseErrorCode = 1006
-
-	// The size of the buffer for holding temporary unprocessed events assuming 1000 is large enough.
-	//
-	// TODO: Though buffer size of 1000 should be sufficiently large, this implementation can potentially
-	// lead to a deadlock. Ideally, this should be an indefinitely large buffered channel.
-	tempBufferSize = 1000
 )
 
+// ErrClosed is returned by the methods of a Connection after Close has been called.
+var ErrClosed = errors.New("devtools: connection closed")
+
 // Page is the struct retrieved from /json/ of Chrome in Debug mode. A Page can be a tab, background process, or other.
 // Each Page needs a separate connection to control using the Devtools Protocol.
 type Page struct {
@@ -55,9 +50,21 @@ type Page struct {
 	WebSocketDebuggerURL string `json:"webSocketDebuggerUrl"`
 }
 
+// BrowserVersion is the struct retrieved from /json/version of Chrome in Debug mode.
+// Its WebSocketDebuggerURL is the browser-level endpoint, from which any target can be controlled.
+type BrowserVersion struct {
+	Browser              string `json:"Browser"`
+	ProtocolVersion      string `json:"Protocol-Version"`
+	UserAgent            string `json:"User-Agent"`
+	V8Version            string `json:"V8-Version"`
+	WebKitVersion        string `json:"WebKit-Version"`
+	WebSocketDebuggerURL string `json:"webSocketDebuggerUrl"`
+}
+
 // EventMessage defines the structure of an event message.
 type EventMessage struct {
 	MessageID int
+	SessionID string `json:"sessionId,omitempty"` // Set when the event belongs to a Session.
 	Method    string `json:"method"`
 	Params    Params `json:"params"`
 }
@@ -116,9 +123,10 @@ func (p Params) String(field string) (string, bool) {
 
 // method holds the information necessary to invoke a method on Chrome. method's are created using the InvokeMethod functions.
 type method struct {
-	ID     int    `json:"id"`
-	Method string `json:"method"`
-	Params Params `json:"params"`
+	ID        int    `json:"id"`
+	SessionID string `json:"sessionId,omitempty"`
+	Method    string `json:"method"`
+	Params    Params `json:"params"`
 }
 
 // ResultType abstracts away the type of the response.
@@ -138,12 +146,41 @@ type Result struct {
 	MessageID int
 	Type      ResultType
 	Params    Params
+
+	err error // Set when the method failed without Chrome responding.
+}
+
+// ProtocolError is returned by Call when Chrome responds to a method with an error object.
+type ProtocolError struct {
+	Code    int
+	Message string
+	Data    string
+}
+
+// Error implements the error interface.
+func (e *ProtocolError) Error() string {
+	if e.Data != "" {
+		return fmt.Sprintf("devtools: %s (%d): %s", e.Message, e.Code, e.Data)
+	}
+	return fmt.Sprintf("devtools: %s (%d)", e.Message, e.Code)
+}
+
+// newProtocolError converts the error object of a response into a ProtocolError.
+func newProtocolError(p Params) *ProtocolError {
+	code, _ := p.Int("code")
+	message, _ := p.String("message")
+	data, _ := p.String("data")
+	return &ProtocolError{
+		Code:    code,
+		Message: message,
+		Data:    data,
+	}
 }
 
 // Connection can communicate with a Chrome instance using the Chrome Devtools Protocol.
 type Connection struct {
-	// Web socket connected to one Chrome's pages
-	sock *websocket.Conn
+	// Carries the messages to and from Chrome, e.g. a web socket connected to one Chrome's pages.
+	transport Transport
 
 	// Channel used to stop sendMessages subroutine.
 	stopSend chan bool
@@ -155,6 +192,14 @@ type Connection struct {
 	// Channel used to send methods to Chrome.
 	toSend chan method
 
+	// Closed once the connection has ended, either by Close or because of an error.
+	done chan struct{}
+
+	// Protects the following fields.
+	errMutex sync.Mutex
+	err      error // The reason the connection ended. Only set once done is closed.
+	closing  bool  // Whether Close has been called.
+
 	// Channels used to get the return values from methods.
 	resultsMutex sync.Mutex
 	results      map[int]chan Result
@@ -166,31 +211,51 @@ type Connection struct {
 	methodIDMutex sync.Mutex
 	nextMethodID  int
 
-	// Buffer for holding unprocessable events.
-	bufferedEvents chan EventMessage
+	// Events that do not belong to a session.
+	events *eventStream
+
+	// Sessions attached through this connection, keyed by session ID.
+	sessionsMutex sync.Mutex
+	sessions      map[string]*Session
+
+	// Records the messages sent and received, if set.
+	recordMutex sync.Mutex
+	recorder    *recorder
+
+	// The liveness of the connection, as determined by keepalive probes.
+	healthMutex      sync.Mutex
+	health           Health
+	keepaliveStarted bool
 
 	// The number of message received.
 	messageReceived int
 }
 
-// NewConnection creates a new Connection, which is connected to the active tab of the Chrome instance specified by hostport.
-// If the Chrome instance at hostport just started, the connection may fail. Chrome takes a few seconds to be ready to connect to.
-func NewConnection(hostport string) (*Connection, error) {
-	// Creates an empty Connection struct.
-	c := &Connection{
-		sock:            nil,
+// newConnection creates an empty Connection to the Chrome instance specified by hostport.
+func newConnection(hostport string) *Connection {
+	return &Connection{
+		transport:       nil,
 		stopSend:        make(chan bool),
 		recvEnded:       make(chan bool),
 		sendEnded:       make(chan bool),
 		toSend:          make(chan method),
+		done:            make(chan struct{}),
 		resultsMutex:    sync.Mutex{},
 		results:         make(map[int]chan Result),
 		hostport:        hostport,
 		methodIDMutex:   sync.Mutex{},
 		nextMethodID:    0,
-		bufferedEvents:  make(chan EventMessage, tempBufferSize),
+		events:          newEventStream(),
+		sessions:        make(map[string]*Session),
+		health:          Health{Healthy: true},
 		messageReceived: 0,
 	}
+}
+
+// NewConnection creates a new Connection, which is connected to the active tab of the Chrome instance specified by hostport.
+// If the Chrome instance at hostport just started, the connection may fail. Chrome takes a few seconds to be ready to connect to.
+func NewConnection(hostport string) (*Connection, error) {
+	c := newConnection(hostport)
 
 	// Finds the active tab.
 	activeTab, err := c.ActiveTab()
@@ -206,6 +271,61 @@ func NewConnection(hostport string) (*Connection, error) {
 	return c, nil
 }
 
+// NewBrowserConnection creates a new Connection to the browser endpoint of the Chrome instance specified by hostport.
+// Tabs are created and controlled through the Sessions returned by NewTab and AttachToTarget, all multiplexed over
+// the single websocket of the connection.
+func NewBrowserConnection(hostport string) (*Connection, error) {
+	c := newConnection(hostport)
+	version, err := c.Version()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.dial(version.WebSocketDebuggerURL); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// NewBrowserConnectionToURL creates a new Connection to the browser-level websocket endpoint of Chrome at debuggerURL,
+// e.g. "ws://host:9222/devtools/browser/<id>", as found in /json/version or printed by Chrome on startup.
+func NewBrowserConnectionToURL(debuggerURL string) (*Connection, error) {
+	u, err := url.Parse(debuggerURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "ws" && u.Scheme != "wss" {
+		return nil, fmt.Errorf("%q is not a websocket URL", debuggerURL)
+	}
+	c := newConnection(u.Host)
+	if err := c.dial(debuggerURL); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// NewConnectionWithTransport creates a new Connection exchanging messages with Chrome over transport,
+// e.g. a ReplayTransport.
+func NewConnectionWithTransport(transport Transport) *Connection {
+	c := newConnection("")
+	c.start(transport)
+	return c
+}
+
+// Version returns the version of Chrome and its browser-level debugger address.
+func (c *Connection) Version() (*BrowserVersion, error) {
+	resp, err := http.Get("http://" + c.hostport + "/json/version")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var version BrowserVersion
+	if err := json.NewDecoder(resp.Body).Decode(&version); err != nil {
+		return nil, err
+	}
+	return &version, nil
+}
+
 // Pages returns a list of the current Pages in Chrome. These will include tabs and background processes active in Chrome.
 func (c *Connection) Pages() ([]*Page, error) {
 	resp, err := http.Get("http://" + c.hostport + "/json")
@@ -240,70 +360,159 @@ func (c *Connection) ActiveTab() (*Page, error) {
 
 // ConnectToPage connects to page's debugger address.
 func (c *Connection) ConnectToPage(page *Page) error {
-	if c.sock != nil {
+	return c.dial(page.WebSocketDebuggerURL)
+}
+
+// dial connects to the debugger address and starts exchanging messages with Chrome.
+func (c *Connection) dial(debuggerURL string) error {
+	if c.transport != nil {
 		return errors.New("sock is already connected to some Page")
 	}
 
-	sock, _, err := websocket.DefaultDialer.Dial(page.WebSocketDebuggerURL, nil)
+	sock, _, err := websocket.DefaultDialer.Dial(debuggerURL, nil)
 	if err != nil {
 		return err
 	}
-	c.sock = sock
+	c.start(newWebSocketTransport(sock))
+	return nil
+}
+
+// start starts exchanging messages with Chrome over transport.
+func (c *Connection) start(transport Transport) {
+	c.transport = transport
 
 	// Starts send and receive subroutines.
 	go c.receiveMessages()
 	go c.sendMessages()
-
-	return nil
 }
 
-// Close closes the connection to Chrome.
+// Close closes the connection to Chrome. Calling Close more than once has no effect.
 func (c *Connection) Close() {
-	// Tell the subroutines to end.
-	c.stopSend <- true
+	c.errMutex.Lock()
+	closing := c.closing
+	c.closing = true
+	c.errMutex.Unlock()
+	if closing {
+		return
+	}
+
+	// Tell the subroutines to end, unless sending has already ended because of an error.
+	select {
+	case c.stopSend <- true:
+	case <-c.sendEnded:
+	}
 
 	// Wait for all of them to end.
 	<-c.sendEnded
 
-	// Send close only after we are done with sending all messages.
-	// Sends a close control message to Chrome, and causes any ReadMessage or WriteMessage to return an "expected" error.
-	c.sock.WriteControl(websocket.CloseMessage, []byte{}, time.Now().Add(10.0*time.Second))
+	// Close only after we are done with sending all messages.
+	// This causes any pending ReadMessage to return an error, which is expected while closing.
+	c.transport.Close()
 
-	// Safe to stop all receiving of messages.
+	// Wait for the receiving of messages to stop.
 	<-c.recvEnded
+}
 
-	// Closes the websocket.
-	c.sock.Close()
-	c.sock = nil
+// Done returns a channel that is closed when the connection has ended, either
+// because Close was called or because of an error communicating with Chrome.
+func (c *Connection) Done() <-chan struct{} {
+	return c.done
+}
+
+// Err returns nil while the connection is alive. Once Done is closed, Err returns
+// ErrClosed if the connection was closed by Close, or the error that ended it.
+func (c *Connection) Err() error {
+	c.errMutex.Lock()
+	defer c.errMutex.Unlock()
+	return c.err
+}
+
+// fail ends the connection with err. Only the first call has an effect.
+func (c *Connection) fail(err error) {
+	c.errMutex.Lock()
+	defer c.errMutex.Unlock()
+	if c.err != nil {
+		return
+	}
+	if err != ErrClosed {
+		fmt.Printf("%v failed: %v\n", c.describe(), err)
+		// Unblocks the other subroutine, if it is waiting on the transport.
+		c.transport.Close()
+	}
+	c.err = err
+	close(c.done)
+}
+
+// describe names the connection in logs.
+func (c *Connection) describe() string {
+	if c.hostport == "" {
+		return "devtools connection"
+	}
+	return "devtools connection to " + c.hostport
+}
+
+// isClosing returns whether Close has been called.
+func (c *Connection) isClosing() bool {
+	c.errMutex.Lock()
+	defer c.errMutex.Unlock()
+	return c.closing
+}
+
+// failPendingMethods returns an error result to every method still waiting for a response.
+func (c *Connection) failPendingMethods() {
+	c.resultsMutex.Lock()
+	defer c.resultsMutex.Unlock()
+	for id, resultChan := range c.results {
+		resultChan <- errorResult(id, c.Err())
+		delete(c.results, id)
+	}
+}
+
+// errorResult returns the Result of the method with the given ID when it failed without Chrome responding.
+func errorResult(methodID int, err error) Result {
+	return Result{
+		ID:     methodID,
+		Type:   ResultError,
+		Params: Params{"message": err.Error()},
+		err:    err,
+	}
 }
 
 // receiveMessages continually receives messages, and processes received messages.
 func (c *Connection) receiveMessages() {
 	// Make sure to close all channels when all messages are received.
-	defer close(c.bufferedEvents)
+	defer c.events.close()
+	defer c.endSessions()
 	defer close(c.recvEnded)
+	defer c.failPendingMethods()
 
 receiveLoop:
 	for {
 		// Receives the data as []bytes.
-		_, data, err := c.sock.ReadMessage()
-		if websocket.IsCloseError(err, expectedCloseErrorCode) {
-			break receiveLoop
-		}
+		data, err := c.transport.ReadMessage()
 		if err != nil {
-			log.Fatal(err)
+			if c.isClosing() {
+				c.fail(ErrClosed)
+			} else {
+				c.fail(fmt.Errorf("devtools: reading from Chrome: %v", err))
+			}
+			break receiveLoop
 		}
 
+		c.record(directionReceived, data)
 		curMessageID := c.messageReceived
 		c.messageReceived++
 
 		// Converts the data from []bytes.
 		var msg map[string]interface{}
-		json.Unmarshal(data, &msg)
+		if err := json.Unmarshal(data, &msg); err != nil {
+			c.fail(fmt.Errorf("devtools: malformed message from Chrome: %v", err))
+			break receiveLoop
+		}
 
 		// Checks if the message was a reply to a method.
-		if id, ok := msg["id"]; ok {
-			idInt := int(id.(float64))
+		if id, ok := msg["id"].(float64); ok {
+			idInt := int(id)
 
 			c.resultsMutex.Lock()
 			resultChan, returnResult := c.results[idInt]
@@ -313,11 +522,11 @@ receiveLoop:
 			if returnResult {
 				var params Params
 				var resultType ResultType
-				if _, ok := msg[string(ResultValid)]; ok {
-					params = Params(msg[string(ResultValid)].(map[string]interface{}))
+				if p, ok := msg[string(ResultValid)].(map[string]interface{}); ok {
+					params = Params(p)
 					resultType = ResultValid
-				} else if _, ok := msg[string(ResultError)]; ok {
-					params = Params(msg[string(ResultError)].(map[string]interface{}))
+				} else if p, ok := msg[string(ResultError)].(map[string]interface{}); ok {
+					params = Params(p)
 					resultType = ResultError
 				}
 				result := Result{
@@ -331,14 +540,16 @@ receiveLoop:
 
 		} else {
 			// Treats any other message as an event.
+			method, _ := msg["method"].(string)
+			params, _ := msg["params"].(map[string]interface{})
+			sessionID, _ := msg["sessionId"].(string)
 			event := EventMessage{
 				MessageID: curMessageID,
-				Method:    msg["method"].(string),
-				Params:    Params(msg["params"].(map[string]interface{})),
+				SessionID: sessionID,
+				Method:    method,
+				Params:    Params(params),
 			}
-
-			// Add the event to a buffer.
-			c.bufferedEvents <- event
+			c.dispatchEvent(event)
 		}
 	}
 }
@@ -356,30 +567,67 @@ sendLoop:
 			// Converts the message to JSON.
 			data, err := json.Marshal(msg)
 			if err != nil {
-				log.Fatal(err)
+				// Only this method is affected, so fail it alone.
+				c.failMethod(msg.ID, fmt.Errorf("devtools: marshaling %v: %v", msg.Method, err))
+				continue sendLoop
 			}
 
 			// Sends the message.
-			err = c.sock.WriteMessage(websocket.TextMessage, data)
-			if websocket.IsCloseError(err, expectedCloseErrorCode) {
-				continue sendLoop
-			}
+			c.record(directionSent, data)
+			err = c.transport.WriteMessage(data)
 			if err != nil {
-				log.Fatal(err)
+				c.fail(fmt.Errorf("devtools: writing to Chrome: %v", err))
+				break sendLoop
 			}
 		}
 	}
 }
 
-// NextEvent returns the next event.
+// failMethod returns an error result to the method with the given ID, if it is waiting for a response.
+func (c *Connection) failMethod(methodID int, err error) {
+	c.resultsMutex.Lock()
+	resultChan, ok := c.results[methodID]
+	delete(c.results, methodID)
+	c.resultsMutex.Unlock()
+	if ok {
+		resultChan <- errorResult(methodID, err)
+	}
+}
+
+// NextEvent returns the next event. Once all events have been returned, it returns io.EOF
+// if the connection was closed by Close, or the error that ended the connection.
 func (c *Connection) NextEvent() (EventMessage, error) {
-	retval, ok := <-c.bufferedEvents
+	return c.NextEventContext(context.Background())
+}
+
+// NextEventContext is like NextEvent, but returns ctx.Err() if ctx is done while waiting for an event.
+// The events already received are returned first.
+func (c *Connection) NextEventContext(ctx context.Context) (EventMessage, error) {
+	retval, ok := c.events.queue.pop(ctx.Done())
 	if !ok {
+		if err := ctx.Err(); err != nil {
+			return retval, err
+		}
+		if err := c.Err(); err != nil && err != ErrClosed {
+			return retval, err
+		}
 		return retval, io.EOF
 	}
 	return retval, nil
 }
 
+// SetEventQueueHighWaterMark sets the number of unprocessed events above which onOverflow is called.
+// Events are never dropped; the high-water mark only controls reporting. If onOverflow is nil,
+// overflows are logged. A highWaterMark of zero or less disables reporting.
+func (c *Connection) SetEventQueueHighWaterMark(highWaterMark int, onOverflow func(EventQueueStats)) {
+	c.events.queue.setHighWaterMark(highWaterMark, onOverflow)
+}
+
+// EventQueueStats returns statistics on the events received but not yet returned by NextEvent.
+func (c *Connection) EventQueueStats() EventQueueStats {
+	return c.events.queue.snapshot()
+}
+
 // newMethodId returns an id for a new method.
 func (c *Connection) newMethodID() int {
 	c.methodIDMutex.Lock()
@@ -390,33 +638,126 @@ func (c *Connection) newMethodID() int {
 }
 
 // InvokeMethod invokes the specified method in Chrome. Doesn't wait for a response.
+// The method is dropped if the connection has ended.
 func (c *Connection) InvokeMethod(methodName string, params Params) {
-	msg := method{
-		ID:     c.newMethodID(),
-		Method: methodName,
-		Params: params,
-	}
-	c.toSend <- msg
+	c.invokeMethod("", methodName, params)
 }
 
 // InvokeMethodAndGetReturn invokes the specified method in Chrome and returns Chrome's response.
 // If an error occurs, the error response will be returned.
+// This blocks until Chrome responds; prefer Call, which can be cancelled.
 func (c *Connection) InvokeMethodAndGetReturn(methodName string, params Params) Result {
-	// TODO: this method doesn't expose the error when something goes bad at the API
-	// level. It would be great to expose such error.
-	methodID := c.newMethodID()
+	return c.invokeMethodAndGetReturn("", methodName, params)
+}
+
+// Call invokes the specified method in Chrome and waits for its return value.
+// If Chrome responds with an error object, a *ProtocolError is returned. If ctx is
+// done before Chrome responds, ctx.Err() is returned and the response is discarded.
+// If the connection ends before Chrome responds, Err() is returned.
+func (c *Connection) Call(ctx context.Context, methodName string, params Params) (Params, error) {
+	return c.call(ctx, "", methodName, params)
+}
 
+// invokeMethod implements InvokeMethod for the connection or one of its sessions.
+func (c *Connection) invokeMethod(sessionID, methodName string, params Params) {
 	msg := method{
-		ID:     methodID,
-		Method: methodName,
-		Params: params,
+		ID:        c.newMethodID(),
+		SessionID: sessionID,
+		Method:    methodName,
+		Params:    params,
+	}
+	select {
+	case c.toSend <- msg:
+	case <-c.done:
+	}
+}
+
+// invokeMethodAndGetReturn implements InvokeMethodAndGetReturn for the connection or one of its sessions.
+func (c *Connection) invokeMethodAndGetReturn(sessionID, methodName string, params Params) Result {
+	methodID, resultChan := c.registerMethod()
+	select {
+	case c.toSend <- method{ID: methodID, SessionID: sessionID, Method: methodName, Params: params}:
+	case <-c.done:
+		c.unregisterMethod(methodID)
+		return errorResult(methodID, c.Err())
+	}
+	select {
+	case result := <-resultChan:
+		return result
+	case <-c.done:
+		select {
+		case result := <-resultChan:
+			return result
+		default:
+			c.unregisterMethod(methodID)
+			return errorResult(methodID, c.Err())
+		}
+	}
+}
+
+// call implements Call for the connection or one of its sessions.
+func (c *Connection) call(ctx context.Context, sessionID, methodName string, params Params) (Params, error) {
+	methodID, resultChan := c.registerMethod()
+	msg := method{
+		ID:        methodID,
+		SessionID: sessionID,
+		Method:    methodName,
+		Params:    params,
+	}
+
+	select {
+	case c.toSend <- msg:
+	case <-ctx.Done():
+		c.unregisterMethod(methodID)
+		return nil, ctx.Err()
+	case <-c.done:
+		c.unregisterMethod(methodID)
+		return nil, c.Err()
+	}
+
+	select {
+	case result := <-resultChan:
+		return result.returnValue()
+	case <-ctx.Done():
+		c.unregisterMethod(methodID)
+		return nil, ctx.Err()
+	case <-c.done:
+		select {
+		case result := <-resultChan:
+			return result.returnValue()
+		default:
+			c.unregisterMethod(methodID)
+			return nil, c.Err()
+		}
+	}
+}
+
+// returnValue converts the result into the return value of Call.
+func (r Result) returnValue() (Params, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if r.Type == ResultError {
+		return nil, newProtocolError(r.Params)
 	}
+	return r.Params, nil
+}
+
+// registerMethod allocates an ID for a new method and registers the channel its result will be delivered on.
+func (c *Connection) registerMethod() (int, chan Result) {
+	methodID := c.newMethodID()
+	// Buffered so that receiveMessages never blocks on a caller that has given up waiting.
+	resultChan := make(chan Result, 1)
 
 	c.resultsMutex.Lock()
-	resultChan := make(chan Result)
 	c.results[methodID] = resultChan
 	c.resultsMutex.Unlock()
+	return methodID, resultChan
+}
 
-	c.toSend <- msg
-	return <-resultChan
+// unregisterMethod drops the pending result of the method with the given ID.
+func (c *Connection) unregisterMethod(methodID int) {
+	c.resultsMutex.Lock()
+	delete(c.results, methodID)
+	c.resultsMutex.Unlock()
 }
@@ -15,6 +15,8 @@
 package devtools
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -22,12 +24,18 @@ import (
 	"net/http/httptest"
 	"os"
 	"os/exec"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/gorilla/websocket"
 )
 
-// Launch Chrome.
+// Launch Chrome. Skips the test if Chrome is not installed.
 func startChrome(t *testing.T, devToolsPort int) (*exec.Cmd, string) {
+	if _, err := exec.LookPath("google-chrome"); err != nil {
+		t.Skipf("google-chrome is not installed: %v", err)
+	}
 	userDir, err := ioutil.TempDir("/tmp/", "")
 	if err != nil {
 		t.Fatalf("Failed to create userDir: %v with error: %v\n", userDir, err)
@@ -73,7 +81,7 @@ func TestReceivingEventsViaChannels(t *testing.T) {
 		pageLoaded := false
 		for {
 			event, err := connection.NextEvent()
-			if err == io.EOF {
+			if err != nil {
 				// no more events to process.
 				break
 			}
@@ -130,3 +138,359 @@ func TestInvokeMethodAndGetReturn(t *testing.T) {
 		}
 	})
 }
+
+// startFakeChrome starts a server that speaks just enough of the DevTools protocol to be connected to.
+// respond is called for every method received and returns the raw messages to send back. Messages without
+// a "method" are responses, and are sent with the ID and session ID of the method.
+func startFakeChrome(t *testing.T, respond func(msg method) []map[string]interface{}) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	mux := http.NewServeMux()
+	var server *httptest.Server
+	mux.HandleFunc("/json", func(w http.ResponseWriter, r *http.Request) {
+		wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/devtools/page/1"
+		json.NewEncoder(w).Encode([]*Page{{ID: "1", Type: TabType, WebSocketDebuggerURL: wsURL}})
+	})
+	mux.HandleFunc("/json/version", func(w http.ResponseWriter, r *http.Request) {
+		wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/devtools/browser/1"
+		json.NewEncoder(w).Encode(&BrowserVersion{Browser: "FakeChrome/1.0", WebSocketDebuggerURL: wsURL})
+	})
+	serveWebSocket := func(w http.ResponseWriter, r *http.Request) {
+		sock, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("Upgrade: %v", err)
+			return
+		}
+		defer sock.Close()
+		// Like Chrome, drop the connection without replying to the close message.
+		sock.SetCloseHandler(func(int, string) error { return nil })
+		for {
+			var msg method
+			if err := sock.ReadJSON(&msg); err != nil {
+				return
+			}
+			if msg.Method == "Fake.crash" {
+				// Drop the connection like a crashed tab would.
+				return
+			}
+			for _, resp := range respond(msg) {
+				if _, ok := resp["method"]; !ok {
+					resp["id"] = msg.ID
+					if msg.SessionID != "" {
+						resp["sessionId"] = msg.SessionID
+					}
+				}
+				sock.WriteJSON(resp)
+			}
+		}
+	}
+	mux.HandleFunc("/devtools/page/1", serveWebSocket)
+	mux.HandleFunc("/devtools/browser/1", serveWebSocket)
+	server = httptest.NewServer(mux)
+	return server
+}
+
+// noResponse is a respond function for startFakeChrome that never responds.
+func noResponse(method) []map[string]interface{} {
+	return nil
+}
+
+func TestCall(t *testing.T) {
+	server := startFakeChrome(t, func(msg method) []map[string]interface{} {
+		switch msg.Method {
+		case "Runtime.evaluate":
+			return []map[string]interface{}{{"result": map[string]interface{}{"value": 42}}}
+		case "Never.respond":
+			return nil
+		default:
+			return []map[string]interface{}{{"error": map[string]interface{}{"code": -32601, "message": "'" + msg.Method + "' wasn't found"}}}
+		}
+	})
+	defer server.Close()
+
+	connection, err := NewConnection(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatalf("NewConnection: %v", err)
+	}
+	defer connection.Close()
+
+	t.Run("result", func(t *testing.T) {
+		result, err := connection.Call(context.Background(), "Runtime.evaluate", Params{})
+		if err != nil {
+			t.Fatalf("Call: %v", err)
+		}
+		if val, ok := result.Int("value"); !ok || val != 42 {
+			t.Errorf("Int('value'): val,ok = (%v, %v), want (%v, %v)", val, ok, 42, true)
+		}
+	})
+
+	t.Run("protocol_error", func(t *testing.T) {
+		_, err := connection.Call(context.Background(), "Random.MethodThatWillNeverExists", Params{})
+		perr, ok := err.(*ProtocolError)
+		if !ok {
+			t.Fatalf("Call: got error %v, want a *ProtocolError", err)
+		}
+		if perr.Code != -32601 {
+			t.Errorf("ProtocolError.Code got: %v, want: %v", perr.Code, -32601)
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		if _, err := connection.Call(ctx, "Never.respond", Params{}); err != context.DeadlineExceeded {
+			t.Errorf("Call: got error %v, want %v", err, context.DeadlineExceeded)
+		}
+		connection.resultsMutex.Lock()
+		pending := len(connection.results)
+		connection.resultsMutex.Unlock()
+		if pending != 0 {
+			t.Errorf("got %v pending results after cancellation, want 0", pending)
+		}
+	})
+}
+
+func TestSubscribe(t *testing.T) {
+	server := startFakeChrome(t, noResponse)
+	defer server.Close()
+
+	connection, err := NewConnection(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatalf("NewConnection: %v", err)
+	}
+
+	domEvents, cancelDOM := connection.Subscribe("DOM.*")
+	defer cancelDOM()
+	loadEvents, cancelLoad := connection.Subscribe("Page.loadEventFired")
+	allEvents, cancelAll := connection.Subscribe("*")
+	defer cancelAll()
+	cancelLoad()
+	if _, ok := <-loadEvents; ok {
+		t.Errorf("channel should be closed after cancel")
+	}
+
+	for _, method := range []string{"DOM.documentUpdated", "Page.loadEventFired", "DOM.childNodeInserted"} {
+		connection.dispatchEvent(EventMessage{Method: method})
+	}
+	for _, want := range []string{"DOM.documentUpdated", "DOM.childNodeInserted"} {
+		if got := (<-domEvents).Method; got != want {
+			t.Errorf("DOM.* subscriber got: %v, want: %v", got, want)
+		}
+	}
+	for _, want := range []string{"DOM.documentUpdated", "Page.loadEventFired", "DOM.childNodeInserted"} {
+		if got := (<-allEvents).Method; got != want {
+			t.Errorf("* subscriber got: %v, want: %v", got, want)
+		}
+	}
+
+	connection.Close()
+	if _, ok := <-domEvents; ok {
+		t.Errorf("channel should be closed after the connection is closed")
+	}
+}
+
+func TestDiscardEvents(t *testing.T) {
+	server := startFakeChrome(t, noResponse)
+	defer server.Close()
+
+	connection, err := NewConnection(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatalf("NewConnection: %v", err)
+	}
+	defer connection.Close()
+	overflows := 0
+	connection.SetEventQueueHighWaterMark(DefaultEventQueueHighWaterMark, func(EventQueueStats) { overflows++ })
+
+	connection.dispatchEvent(EventMessage{Method: "Page.loadEventFired"})
+	events, cancel := connection.Subscribe("*")
+	defer cancel()
+	connection.DiscardEvents()
+	start := time.Now()
+	for i := 0; i <= DefaultEventQueueHighWaterMark; i++ {
+		connection.dispatchEvent(EventMessage{Method: "DOM.childNodeInserted"})
+	}
+	for i := 0; i <= DefaultEventQueueHighWaterMark; i++ {
+		<-events
+	}
+	stats := connection.EventQueueStats()
+	if stats.Len != 0 || overflows != 0 {
+		t.Errorf("events were kept for NextEvent after DiscardEvents: %+v, %v overflows", stats, overflows)
+	}
+	// The discarded events still tell when the connection was last active.
+	if stats.LastReceived.Before(start) {
+		t.Errorf("LastReceived = %v, want after %v", stats.LastReceived, start)
+	}
+}
+
+func TestMatchesPattern(t *testing.T) {
+	tests := []struct {
+		pattern, method string
+		want            bool
+	}{
+		{"DOM.*", "DOM.childNodeInserted", true},
+		{"DOM.*", "DOMStorage.domStorageItemAdded", false},
+		{"Page.loadEventFired", "Page.loadEventFired", true},
+		{"Page.loadEventFired", "Page.frameNavigated", false},
+		{"*", "Network.requestWillBeSent", true},
+	}
+	for _, test := range tests {
+		if got := matchesPattern(test.pattern, test.method); got != test.want {
+			t.Errorf("matchesPattern(%q, %q) got: %v, want: %v", test.pattern, test.method, got, test.want)
+		}
+	}
+}
+
+func TestConnectionErrors(t *testing.T) {
+	server := startFakeChrome(t, noResponse)
+	defer server.Close()
+
+	t.Run("crash", func(t *testing.T) {
+		connection, err := NewConnection(strings.TrimPrefix(server.URL, "http://"))
+		if err != nil {
+			t.Fatalf("NewConnection: %v", err)
+		}
+		defer connection.Close()
+
+		// Never answered, so it is still in flight when the connection drops.
+		pending := make(chan error)
+		go func() {
+			_, err := connection.Call(context.Background(), "Never.respond", Params{})
+			pending <- err
+		}()
+		connection.InvokeMethod("Fake.crash", Params{})
+
+		select {
+		case <-connection.Done():
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Done was not closed after the connection dropped")
+		}
+		if err := connection.Err(); err == nil || err == ErrClosed {
+			t.Errorf("Err got: %v, want the error that ended the connection", err)
+		}
+		if err := <-pending; err != connection.Err() {
+			t.Errorf("in-flight Call got error: %v, want: %v", err, connection.Err())
+		}
+		if _, err := connection.NextEvent(); err != connection.Err() {
+			t.Errorf("NextEvent got error: %v, want: %v", err, connection.Err())
+		}
+		if result := connection.InvokeMethodAndGetReturn("Runtime.evaluate", Params{}); result.Type != ResultError {
+			t.Errorf("InvokeMethodAndGetReturn after the connection ended should return an error")
+		}
+	})
+
+	t.Run("close", func(t *testing.T) {
+		connection, err := NewConnection(strings.TrimPrefix(server.URL, "http://"))
+		if err != nil {
+			t.Fatalf("NewConnection: %v", err)
+		}
+		connection.Close()
+		<-connection.Done()
+		if err := connection.Err(); err != ErrClosed {
+			t.Errorf("Err got: %v, want: %v", err, ErrClosed)
+		}
+		if _, err := connection.NextEvent(); err != io.EOF {
+			t.Errorf("NextEvent got error: %v, want: %v", err, io.EOF)
+		}
+	})
+}
+
+func TestSessions(t *testing.T) {
+	server := startFakeChrome(t, func(msg method) []map[string]interface{} {
+		switch msg.Method {
+		case "Target.createTarget":
+			return []map[string]interface{}{{"result": map[string]interface{}{"targetId": "target-" + msg.Params["browserContextId"].(string)}}}
+		case "Target.attachToTarget":
+			targetID := msg.Params["targetId"].(string)
+			return []map[string]interface{}{{"result": map[string]interface{}{"sessionId": "session-" + targetID}}}
+		case "Page.navigate":
+			// Reply from within the session, and fire an event in that session only.
+			return []map[string]interface{}{
+				{"result": map[string]interface{}{"frameId": msg.SessionID}},
+				{"method": "Page.loadEventFired", "sessionId": msg.SessionID, "params": map[string]interface{}{}},
+			}
+		case "Target.closeTarget":
+			sessionID := "session-" + msg.Params["targetId"].(string)
+			return []map[string]interface{}{
+				{"method": "Target.detachedFromTarget", "params": map[string]interface{}{"sessionId": sessionID}},
+				{"result": map[string]interface{}{"success": true}},
+			}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	connection, err := NewBrowserConnection(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatalf("NewBrowserConnection: %v", err)
+	}
+	defer connection.Close()
+
+	ctx := context.Background()
+	first, err := connection.NewTab(ctx, "a")
+	if err != nil {
+		t.Fatalf("NewTab: %v", err)
+	}
+	second, err := connection.NewTab(ctx, "b")
+	if err != nil {
+		t.Fatalf("NewTab: %v", err)
+	}
+	if first.ID != "session-target-a" || second.ID != "session-target-b" {
+		t.Fatalf("session IDs got: (%v, %v), want: (session-target-a, session-target-b)", first.ID, second.ID)
+	}
+
+	result, err := second.Call(ctx, "Page.navigate", Params{"url": "about:blank"})
+	if err != nil {
+		t.Fatalf("Call: %v", err)
+	}
+	if frameID, _ := result.String("frameId"); frameID != second.ID {
+		t.Errorf("frameId got: %v, want: %v", frameID, second.ID)
+	}
+	event, err := second.NextEvent()
+	if err != nil || event.Method != "Page.loadEventFired" || event.SessionID != second.ID {
+		t.Errorf("NextEvent got: (%+v, %v), want Page.loadEventFired of %v", event, err, second.ID)
+	}
+	if stats := first.EventQueueStats(); stats.Received != 0 {
+		t.Errorf("the first session received %v events of the second session", stats.Received)
+	}
+	if stats := connection.EventQueueStats(); stats.Received != 0 {
+		t.Errorf("the connection received %v events of the second session", stats.Received)
+	}
+
+	if err := first.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	<-first.Done()
+	if _, err := first.NextEvent(); err != io.EOF {
+		t.Errorf("NextEvent after Close got error: %v, want: %v", err, io.EOF)
+	}
+
+	connection.Close()
+	<-second.Done()
+	if err := second.Err(); err != ErrClosed {
+		t.Errorf("Err after the connection closed got: %v, want: %v", err, ErrClosed)
+	}
+}
+
+func TestNewBrowserConnectionToURL(t *testing.T) {
+	server := startFakeChrome(t, func(msg method) []map[string]interface{} {
+		return []map[string]interface{}{{"result": map[string]interface{}{"product": "FakeChrome/1.0"}}}
+	})
+	defer server.Close()
+
+	if _, err := NewBrowserConnectionToURL(server.URL + "/devtools/browser/1"); err == nil {
+		t.Errorf("NewBrowserConnectionToURL of an http URL succeeded, want an error")
+	}
+	connection, err := NewBrowserConnectionToURL("ws" + strings.TrimPrefix(server.URL, "http") + "/devtools/browser/1")
+	if err != nil {
+		t.Fatalf("NewBrowserConnectionToURL: %v", err)
+	}
+	defer connection.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	result, err := connection.Call(ctx, "Browser.getVersion", Params{})
+	if err != nil {
+		t.Fatalf("Browser.getVersion: %v", err)
+	}
+	if product, _ := result.String("product"); product != "FakeChrome/1.0" {
+		t.Errorf("product = %q, want FakeChrome/1.0", product)
+	}
+}
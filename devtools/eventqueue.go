@@ -0,0 +1,195 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devtools
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultEventQueueHighWaterMark is the number of unprocessed events above which a Connection reports an overflow.
+	DefaultEventQueueHighWaterMark = 10000
+
+	// The initial capacity of the ring buffer holding unprocessed events.
+	initialEventQueueCapacity = 64
+)
+
+// EventQueueStats describes the state of the queue holding events that have been received but not yet processed.
+type EventQueueStats struct {
+	Len           int       // The number of events currently in the queue.
+	Peak          int       // The largest number of events the queue has held.
+	Received      int       // The number of events ever pushed to the queue.
+	HighWaterMark int       // The length above which the queue reports an overflow.
+	Overflows     int       // The number of times the length of the queue went above HighWaterMark.
+	LastReceived  time.Time // When the last event was received, even if discarded. Zero if none was.
+}
+
+// eventQueue is an unbounded FIFO of events. Pushing never blocks, so the goroutine
+// reading from the websocket can never be stalled by a slow consumer.
+type eventQueue struct {
+	mu     sync.Mutex
+	buf    []EventMessage // Ring buffer holding the events.
+	head   int            // Index of the oldest event in buf.
+	closed bool           // Whether no more events will be pushed.
+	stats  EventQueueStats
+
+	// Receives a value whenever an event is pushed or the queue is closed.
+	notify chan struct{}
+
+	// Called, outside of the lock, every time the length goes above the high-water mark.
+	onOverflow func(EventQueueStats)
+}
+
+// newEventQueue creates an empty eventQueue.
+func newEventQueue(highWaterMark int) *eventQueue {
+	return &eventQueue{
+		buf:    make([]EventMessage, initialEventQueueCapacity),
+		notify: make(chan struct{}, 1),
+		stats:  EventQueueStats{HighWaterMark: highWaterMark},
+		onOverflow: func(s EventQueueStats) {
+			fmt.Printf("devtools event queue is above its high-water mark: %+v\n", s)
+		},
+	}
+}
+
+// push appends the event to the queue. Events pushed after close are dropped.
+func (q *eventQueue) push(event EventMessage) {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return
+	}
+	if q.stats.Len == len(q.buf) {
+		q.grow()
+	}
+	q.buf[(q.head+q.stats.Len)%len(q.buf)] = event
+	q.stats.Len++
+	q.stats.Received++
+	q.stats.LastReceived = time.Now()
+	if q.stats.Len > q.stats.Peak {
+		q.stats.Peak = q.stats.Len
+	}
+	overflowed := q.stats.HighWaterMark > 0 && q.stats.Len == q.stats.HighWaterMark+1
+	if overflowed {
+		q.stats.Overflows++
+	}
+	stats := q.stats
+	onOverflow := q.onOverflow
+	q.mu.Unlock()
+
+	q.signal()
+	if overflowed && onOverflow != nil {
+		onOverflow(stats)
+	}
+}
+
+// grow doubles the capacity of the ring buffer. Must be called with q.mu held.
+func (q *eventQueue) grow() {
+	buf := make([]EventMessage, 2*len(q.buf))
+	n := copy(buf, q.buf[q.head:])
+	copy(buf[n:], q.buf[:q.head])
+	q.buf = buf
+	q.head = 0
+}
+
+// pop removes and returns the oldest event, blocking until one is available, or done is closed.
+// Returns false once the queue is closed and drained, or done is closed while it is empty. A nil
+// done blocks until an event is available or the queue is closed.
+func (q *eventQueue) pop(done <-chan struct{}) (EventMessage, bool) {
+	for {
+		q.mu.Lock()
+		if q.stats.Len > 0 {
+			event := q.buf[q.head]
+			q.buf[q.head] = EventMessage{} // Release the params for garbage collection.
+			q.head = (q.head + 1) % len(q.buf)
+			q.stats.Len--
+			if q.stats.Len == 0 && len(q.buf) > initialEventQueueCapacity {
+				// Give back the memory used during a burst of events.
+				q.buf = make([]EventMessage, initialEventQueueCapacity)
+				q.head = 0
+			}
+			remaining := q.stats.Len > 0 || q.closed
+			q.mu.Unlock()
+			if remaining {
+				// Pass the wakeup on in case there are other consumers waiting.
+				q.signal()
+			}
+			return event, true
+		}
+		if q.closed {
+			q.mu.Unlock()
+			q.signal()
+			return EventMessage{}, false
+		}
+		q.mu.Unlock()
+		select {
+		case <-q.notify:
+		case <-done:
+			return EventMessage{}, false
+		}
+	}
+}
+
+// discard records that an event was received, without keeping it in the queue.
+func (q *eventQueue) discard() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.stats.LastReceived = time.Now()
+}
+
+// drain drops the events in the queue.
+func (q *eventQueue) drain() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.buf = make([]EventMessage, initialEventQueueCapacity)
+	q.head = 0
+	q.stats.Len = 0
+}
+
+// close marks the queue as closed. Events already queued can still be popped.
+func (q *eventQueue) close() {
+	q.mu.Lock()
+	q.closed = true
+	q.mu.Unlock()
+	q.signal()
+}
+
+// signal wakes up a consumer blocked in pop without blocking the caller.
+func (q *eventQueue) signal() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// setHighWaterMark changes the length above which the queue reports an overflow.
+// A value of zero or less disables reporting.
+func (q *eventQueue) setHighWaterMark(highWaterMark int, onOverflow func(EventQueueStats)) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.stats.HighWaterMark = highWaterMark
+	if onOverflow != nil {
+		q.onOverflow = onOverflow
+	}
+}
+
+// snapshot returns the current statistics of the queue.
+func (q *eventQueue) snapshot() EventQueueStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.stats
+}
@@ -0,0 +1,109 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devtools
+
+import (
+	"testing"
+	"time"
+)
+
+// Tests that pushing far more events than the high-water mark never blocks and preserves order.
+func TestEventQueueUnbounded(t *testing.T) {
+	const numEvents = 50000
+	q := newEventQueue(1000)
+	overflows := 0
+	q.setHighWaterMark(1000, func(EventQueueStats) { overflows++ })
+	if last := q.snapshot().LastReceived; !last.IsZero() {
+		t.Errorf("LastReceived of an empty queue = %v, want zero", last)
+	}
+
+	start := time.Now()
+	for i := 0; i < numEvents; i++ {
+		q.push(EventMessage{MessageID: i, Method: "DOM.childNodeInserted"})
+	}
+	q.close()
+
+	stats := q.snapshot()
+	if stats.Len != numEvents || stats.Peak != numEvents || stats.Received != numEvents {
+		t.Errorf("stats got: %+v, want Len, Peak and Received of %v", stats, numEvents)
+	}
+	if stats.Overflows != 1 || overflows != 1 {
+		t.Errorf("overflows got: (%v, %v), want: (1, 1)", stats.Overflows, overflows)
+	}
+	if stats.LastReceived.Before(start) {
+		t.Errorf("LastReceived = %v, want after %v", stats.LastReceived, start)
+	}
+
+	for i := 0; i < numEvents; i++ {
+		event, ok := q.pop(nil)
+		if !ok || event.MessageID != i {
+			t.Fatalf("pop: got (%v, %v), want (%v, true)", event.MessageID, ok, i)
+		}
+	}
+	if _, ok := q.pop(nil); ok {
+		t.Errorf("pop on a closed and drained queue should return false")
+	}
+}
+
+// Tests that pop blocks until an event is pushed.
+func TestEventQueueBlockingPop(t *testing.T) {
+	q := newEventQueue(DefaultEventQueueHighWaterMark)
+	popped := make(chan EventMessage)
+	go func() {
+		event, _ := q.pop(nil)
+		popped <- event
+	}()
+
+	select {
+	case <-popped:
+		t.Fatalf("pop returned before any event was pushed")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	q.push(EventMessage{Method: "Page.loadEventFired"})
+	select {
+	case event := <-popped:
+		if event.Method != "Page.loadEventFired" {
+			t.Errorf("pop got: %v, want: Page.loadEventFired", event.Method)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("pop did not return after an event was pushed")
+	}
+}
+
+// Tests that pop stops waiting once done is closed, but returns the events already queued first.
+func TestEventQueuePopDone(t *testing.T) {
+	q := newEventQueue(DefaultEventQueueHighWaterMark)
+	q.push(EventMessage{Method: "Page.loadEventFired"})
+	done := make(chan struct{})
+	close(done)
+
+	if event, ok := q.pop(done); !ok || event.Method != "Page.loadEventFired" {
+		t.Errorf("pop got: (%v, %v), want: (Page.loadEventFired, true)", event.Method, ok)
+	}
+	popped := make(chan bool)
+	go func() {
+		_, ok := q.pop(done)
+		popped <- ok
+	}()
+	select {
+	case ok := <-popped:
+		if ok {
+			t.Errorf("pop of an empty queue once done returned an event")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("pop did not return once done was closed")
+	}
+}
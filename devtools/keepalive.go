@@ -0,0 +1,172 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devtools
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultKeepaliveInterval is the time between two probes of a Connection, if KeepaliveOptions.Interval is not set.
+	DefaultKeepaliveInterval = 5 * time.Second
+	// DefaultKeepaliveMaxMissed is the number of consecutive probes a Connection can miss before it is unhealthy,
+	// if KeepaliveOptions.MaxMissed is not set.
+	DefaultKeepaliveMaxMissed = 2
+)
+
+// KeepaliveOptions configures the probes sent by Connection.StartKeepalive.
+type KeepaliveOptions struct {
+	// Interval is the time between two probes. Defaults to DefaultKeepaliveInterval.
+	Interval time.Duration
+	// Timeout is the time allowed for a probe to be answered. Defaults to Interval.
+	Timeout time.Duration
+	// MaxMissed is the number of consecutive probes that can be missed before the connection
+	// is unhealthy. Defaults to DefaultKeepaliveMaxMissed.
+	MaxMissed int
+	// ProbeMethod, e.g. "Browser.getVersion", is invoked as part of every probe if set. Any response,
+	// even an error, answers the probe. Transports that cannot send websocket pings, e.g. pipes, are
+	// probed with "Browser.getVersion" if ProbeMethod is not set.
+	ProbeMethod string
+	ProbeParams Params
+	// OnUnhealthy, if set, is called when the connection becomes unhealthy, with the reason.
+	OnUnhealthy func(err error)
+}
+
+// Health is the liveness of a Connection, as determined by its keepalive probes.
+type Health struct {
+	Healthy    bool
+	Missed     int           // The number of consecutive probes missed.
+	LastAnswer time.Time     // The time the last answered probe was sent. Zero if no probe was answered.
+	RTT        time.Duration // The round trip time of the last answered probe.
+	Err        error         // Why the last probe was missed. Nil if it was answered.
+}
+
+// pinger is implemented by the transports that can probe Chrome without invoking a method, e.g. with websocket pings.
+type pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// StartKeepalive probes Chrome every opts.Interval, until the connection ends. The connection becomes
+// unhealthy once opts.MaxMissed consecutive probes have not been answered in time, and healthy again
+// once a probe is answered. Unhealthy connections are not closed: see Health. Only the first call has an effect.
+func (c *Connection) StartKeepalive(opts KeepaliveOptions) {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultKeepaliveInterval
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = opts.Interval
+	}
+	if opts.MaxMissed <= 0 {
+		opts.MaxMissed = DefaultKeepaliveMaxMissed
+	}
+	if _, ok := c.transport.(pinger); !ok && opts.ProbeMethod == "" {
+		opts.ProbeMethod = "Browser.getVersion"
+	}
+	if opts.ProbeParams == nil {
+		opts.ProbeParams = Params{}
+	}
+
+	c.healthMutex.Lock()
+	defer c.healthMutex.Unlock()
+	if c.keepaliveStarted {
+		return
+	}
+	c.keepaliveStarted = true
+	go c.keepalive(opts)
+}
+
+// Health returns the liveness of the connection. Connections are healthy until their keepalive probes
+// are missed, or they end.
+func (c *Connection) Health() Health {
+	c.healthMutex.Lock()
+	health := c.health
+	c.healthMutex.Unlock()
+	if err := c.Err(); err != nil {
+		health.Healthy = false
+		health.Err = err
+	}
+	return health
+}
+
+// Healthy returns whether the connection is alive and answers its keepalive probes.
+func (c *Connection) Healthy() bool {
+	return c.Health().Healthy
+}
+
+// keepalive probes Chrome every opts.Interval until the connection ends.
+func (c *Connection) keepalive(opts KeepaliveOptions) {
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+		}
+
+		start := time.Now()
+		err := c.probe(opts)
+		if c.Err() != nil {
+			// The probe failed because the connection ended.
+			return
+		}
+
+		c.healthMutex.Lock()
+		becameUnhealthy := false
+		if err == nil {
+			c.health = Health{
+				Healthy:    true,
+				LastAnswer: start,
+				RTT:        time.Since(start),
+			}
+		} else {
+			c.health.Missed++
+			c.health.Err = err
+			if c.health.Healthy && c.health.Missed >= opts.MaxMissed {
+				c.health.Healthy = false
+				becameUnhealthy = true
+			}
+		}
+		c.healthMutex.Unlock()
+
+		if becameUnhealthy {
+			err := fmt.Errorf("devtools: %d keepalive probes missed: %v", opts.MaxMissed, err)
+			fmt.Printf("%v is unhealthy: %v\n", c.describe(), err)
+			if opts.OnUnhealthy != nil {
+				opts.OnUnhealthy(err)
+			}
+		}
+	}
+}
+
+// probe sends one keepalive probe, and returns nil if it was answered in time.
+func (c *Connection) probe(opts KeepaliveOptions) error {
+	ctx, cancel := context.WithTimeout(context.Background(), opts.Timeout)
+	defer cancel()
+	if p, ok := c.transport.(pinger); ok {
+		if err := p.Ping(ctx); err != nil {
+			return fmt.Errorf("ping: %v", err)
+		}
+	}
+	if opts.ProbeMethod != "" {
+		_, err := c.Call(ctx, opts.ProbeMethod, opts.ProbeParams)
+		if _, ok := err.(*ProtocolError); err != nil && !ok {
+			return fmt.Errorf("%v: %v", opts.ProbeMethod, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devtools
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestKeepalive(t *testing.T) {
+	server := startFakeChrome(t, func(msg method) []map[string]interface{} {
+		if msg.Method == "Browser.getVersion" {
+			return []map[string]interface{}{{"result": map[string]interface{}{"product": "FakeChrome/1.0"}}}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	t.Run("healthy", func(t *testing.T) {
+		connection, err := NewConnection(strings.TrimPrefix(server.URL, "http://"))
+		if err != nil {
+			t.Fatalf("NewConnection: %v", err)
+		}
+		defer connection.Close()
+		connection.StartKeepalive(KeepaliveOptions{Interval: 10 * time.Millisecond, ProbeMethod: "Browser.getVersion"})
+
+		deadline := time.Now().Add(5 * time.Second)
+		for connection.Health().LastAnswer.IsZero() {
+			if time.Now().After(deadline) {
+				t.Fatalf("no keepalive probe was answered")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		if health := connection.Health(); !health.Healthy || health.Missed != 0 {
+			t.Errorf("Health() = %+v, want healthy", health)
+		}
+
+		connection.Close()
+		if connection.Healthy() {
+			t.Errorf("Healthy() = true after Close, want false")
+		}
+	})
+
+	t.Run("missed_probes", func(t *testing.T) {
+		connection, err := NewConnection(strings.TrimPrefix(server.URL, "http://"))
+		if err != nil {
+			t.Fatalf("NewConnection: %v", err)
+		}
+		defer connection.Close()
+		unhealthy := make(chan error, 1)
+		connection.StartKeepalive(KeepaliveOptions{
+			Interval:    10 * time.Millisecond,
+			MaxMissed:   2,
+			ProbeMethod: "Never.respond",
+			OnUnhealthy: func(err error) { unhealthy <- err },
+		})
+
+		select {
+		case <-unhealthy:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("the connection did not become unhealthy")
+		}
+		health := connection.Health()
+		if health.Healthy || health.Missed < 2 || health.Err == nil {
+			t.Errorf("Health() = %+v, want unhealthy after 2 missed probes", health)
+		}
+		if err := connection.Err(); err != nil {
+			t.Errorf("Err() = %v, unhealthy connections should not be closed", err)
+		}
+	})
+}
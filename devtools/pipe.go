@@ -0,0 +1,70 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devtools
+
+import (
+	"bufio"
+	"io"
+)
+
+// The delimiter of the messages exchanged over --remote-debugging-pipe.
+const pipeDelim = 0
+
+// pipeTransport is the Transport of a Connection to a Chrome started with --remote-debugging-pipe.
+// Chrome reads methods from its file descriptor 3 and writes messages to its file descriptor 4,
+// each message being JSON followed by a NUL byte.
+type pipeTransport struct {
+	r      *bufio.Reader
+	closeR io.Closer
+	w      io.WriteCloser
+}
+
+// NewPipeTransport returns a Transport reading the messages of Chrome from r, the other end of
+// Chrome's file descriptor 4, and writing methods to w, the other end of Chrome's file descriptor 3.
+// The pipe is browser-level: tabs are controlled through Sessions, as with NewBrowserConnection.
+func NewPipeTransport(r io.ReadCloser, w io.WriteCloser) Transport {
+	return &pipeTransport{
+		r:      bufio.NewReader(r),
+		closeR: r,
+		w:      w,
+	}
+}
+
+// ReadMessage implements Transport.
+func (t *pipeTransport) ReadMessage() ([]byte, error) {
+	data, err := t.r.ReadBytes(pipeDelim)
+	if err != nil {
+		if err == io.EOF && len(data) > 0 {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return data[:len(data)-1], nil
+}
+
+// WriteMessage implements Transport.
+func (t *pipeTransport) WriteMessage(data []byte) error {
+	_, err := t.w.Write(append(data, pipeDelim))
+	return err
+}
+
+// Close closes both pipes. Chrome exits once the pipe it reads methods from is closed.
+func (t *pipeTransport) Close() error {
+	err := t.w.Close()
+	if rerr := t.closeR.Close(); err == nil {
+		err = rerr
+	}
+	return err
+}
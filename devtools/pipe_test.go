@@ -0,0 +1,91 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devtools
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestPipeTransport(t *testing.T) {
+	chromeIn, proxyOut := io.Pipe()
+	proxyIn, chromeOut := io.Pipe()
+	connection := NewConnectionWithTransport(NewPipeTransport(proxyIn, proxyOut))
+
+	// Plays the part of Chrome: answers every method, then sends an event split across two writes.
+	chromeEnded := make(chan struct{})
+	go func() {
+		defer close(chromeEnded)
+		defer chromeOut.Close()
+		reader := bufio.NewReader(chromeIn)
+		for {
+			data, err := reader.ReadBytes(0)
+			if err != nil {
+				return
+			}
+			var msg method
+			if err := json.Unmarshal(data[:len(data)-1], &msg); err != nil {
+				t.Errorf("malformed method %q: %v", data, err)
+				return
+			}
+			resp, _ := json.Marshal(map[string]interface{}{"id": msg.ID, "sessionId": msg.SessionID, "result": Params{"value": 42}})
+			chromeOut.Write(append(resp, 0))
+			chromeOut.Write([]byte(`{"method": "Page.loadEv`))
+			chromeOut.Write([]byte("entFired\", \"params\": {}}\x00"))
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	result, err := connection.Call(ctx, "Runtime.evaluate", Params{"expression": "42"})
+	if err != nil {
+		t.Fatalf("Call: %v", err)
+	}
+	if val, ok := result.Int("value"); !ok || val != 42 {
+		t.Errorf("Int('value'): val,ok = (%v, %v), want (%v, %v)", val, ok, 42, true)
+	}
+	event, err := connection.NextEvent()
+	if err != nil || event.Method != "Page.loadEventFired" {
+		t.Errorf("NextEvent got: %v, %v, want Page.loadEventFired", event, err)
+	}
+
+	// Closing the connection closes the pipes, which ends Chrome.
+	connection.Close()
+	select {
+	case <-chromeEnded:
+	case <-ctx.Done():
+		t.Fatalf("the pipes were not closed")
+	}
+	if err := connection.Err(); err != ErrClosed {
+		t.Errorf("Err() got: %v, want: %v", err, ErrClosed)
+	}
+}
+
+func TestPipeTransportTruncated(t *testing.T) {
+	proxyIn, chromeOut := io.Pipe()
+	_, proxyOut := io.Pipe()
+	transport := NewPipeTransport(proxyIn, proxyOut)
+	go func() {
+		chromeOut.Write([]byte(`{"method": "Page.loadEventFired"}`))
+		chromeOut.Close()
+	}()
+	if _, err := transport.ReadMessage(); err != io.ErrUnexpectedEOF {
+		t.Errorf("ReadMessage got error: %v, want: %v", err, io.ErrUnexpectedEOF)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devtools
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Values for RecordedMessage.Direction.
+const (
+	directionSent     = "send"
+	directionReceived = "recv"
+)
+
+// RecordedMessage is a message exchanged with Chrome, as written by Connection.Record.
+// A recording holds one RecordedMessage per line, in the order the messages were exchanged.
+type RecordedMessage struct {
+	Time      time.Time       `json:"time"`
+	Direction string          `json:"direction"` // "send" for methods sent to Chrome, "recv" for messages received from it.
+	Data      json.RawMessage `json:"data"`      // The message, exactly as exchanged.
+}
+
+// Sent returns whether the message was sent to Chrome.
+func (m *RecordedMessage) Sent() bool {
+	return m.Direction == directionSent
+}
+
+// recorder writes the messages of a Connection to a recording.
+type recorder struct {
+	enc *json.Encoder
+}
+
+// Record writes every method sent and every message received from now on to w, as one
+// JSON encoded RecordedMessage per line. Recording stops when Record is called with a nil
+// writer, or after the first write error. The recording can be replayed with a ReplayTransport.
+func (c *Connection) Record(w io.Writer) {
+	c.recordMutex.Lock()
+	defer c.recordMutex.Unlock()
+	if w == nil {
+		c.recorder = nil
+		return
+	}
+	c.recorder = &recorder{enc: json.NewEncoder(w)}
+}
+
+// record writes the message to the recording, if the connection is being recorded.
+func (c *Connection) record(direction string, data []byte) {
+	c.recordMutex.Lock()
+	defer c.recordMutex.Unlock()
+	if c.recorder == nil {
+		return
+	}
+	msg := RecordedMessage{
+		Time:      time.Now(),
+		Direction: direction,
+		Data:      json.RawMessage(data),
+	}
+	if err := c.recorder.enc.Encode(&msg); err != nil {
+		fmt.Printf("failed to record devtools message, recording stopped: %v\n", err)
+		c.recorder = nil
+	}
+}
+
+// ReadRecording reads the messages written by Connection.Record.
+func ReadRecording(r io.Reader) ([]RecordedMessage, error) {
+	var messages []RecordedMessage
+	scanner := bufio.NewScanner(r)
+	// Responses to DOM.getDocument hold the whole DOM, so lines can be long.
+	scanner.Buffer(nil, 64*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var msg RecordedMessage
+		if err := json.Unmarshal([]byte(line), &msg); err != nil {
+			return nil, fmt.Errorf("reading recorded message %d: %v", len(messages)+1, err)
+		}
+		if msg.Direction != directionSent && msg.Direction != directionReceived {
+			return nil, fmt.Errorf("reading recorded message %d: unknown direction %q", len(messages)+1, msg.Direction)
+		}
+		messages = append(messages, msg)
+	}
+	return messages, scanner.Err()
+}
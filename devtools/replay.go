@@ -0,0 +1,209 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devtools
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// ErrRecordingEnded is returned by ReplayTransport.ReadMessage once every recorded message has been replayed.
+var ErrRecordingEnded = errors.New("devtools: end of recording")
+
+// The error code Chrome responds with to unknown methods.
+const methodNotFoundCode = -32601
+
+// messageHeader holds the fields of a message used to match methods with their responses.
+type messageHeader struct {
+	ID        *int   `json:"id"`
+	SessionID string `json:"sessionId"`
+	Method    string `json:"method"`
+}
+
+// recordedMethod is a method sent in a recording.
+type recordedMethod struct {
+	id        int
+	sessionID string
+	method    string
+	matched   bool // Whether the replayed connection has sent the method.
+	liveID    int  // The ID of the method sent by the replayed connection. Only set once matched.
+}
+
+// ReplayTransport is a Transport that plays the part of Chrome in a recording written by Connection.Record.
+//
+// Each method sent over the transport is matched with the first recorded method of the same name and session
+// that has not been matched yet; parameters are not compared. The messages received in the recording are
+// replayed in order, as fast as they are read. A response is held back until its method has been matched,
+// and is replayed with the ID of the matched method. Methods that are not in the recording fail as unknown
+// methods do in Chrome. Once every recorded message has been replayed, ReadMessage returns ErrRecordingEnded.
+type ReplayTransport struct {
+	mu          sync.Mutex
+	cond        *sync.Cond              // Signaled whenever a method is sent or the transport is closed.
+	methods     []*recordedMethod       // The methods sent in the recording, in order.
+	methodsByID map[int]*recordedMethod // The methods sent in the recording, keyed by their recorded ID.
+	received    []RecordedMessage       // The messages received in the recording, in order.
+	next        int                     // The index of the next message of received to replay.
+	responses   [][]byte                // Responses to methods that are not in the recording.
+	unexpected  []string                // The methods sent that are not in the recording.
+	closed      bool
+}
+
+// NewReplayTransport returns a ReplayTransport replaying the recording.
+func NewReplayTransport(recording []RecordedMessage) (*ReplayTransport, error) {
+	t := &ReplayTransport{methodsByID: make(map[int]*recordedMethod)}
+	t.cond = sync.NewCond(&t.mu)
+	for i, msg := range recording {
+		if !msg.Sent() {
+			t.received = append(t.received, msg)
+			continue
+		}
+		var header messageHeader
+		if err := json.Unmarshal(msg.Data, &header); err != nil || header.ID == nil {
+			return nil, fmt.Errorf("recorded message %d is not a method: %s", i+1, msg.Data)
+		}
+		m := &recordedMethod{
+			id:        *header.ID,
+			sessionID: header.SessionID,
+			method:    header.Method,
+		}
+		t.methods = append(t.methods, m)
+		t.methodsByID[m.id] = m
+	}
+	return t, nil
+}
+
+// ReadMessage implements Transport.
+func (t *ReplayTransport) ReadMessage() ([]byte, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for {
+		if t.closed {
+			return nil, io.ErrClosedPipe
+		}
+		if len(t.responses) > 0 {
+			data := t.responses[0]
+			t.responses = t.responses[1:]
+			return data, nil
+		}
+		if t.next >= len(t.received) {
+			return nil, ErrRecordingEnded
+		}
+		data, ok, err := t.replay(t.received[t.next])
+		if err != nil {
+			return nil, fmt.Errorf("replaying recorded message: %v", err)
+		}
+		if ok {
+			t.next++
+			return data, nil
+		}
+		// Wait for the method the message responds to.
+		t.cond.Wait()
+	}
+}
+
+// replay returns the message to replay for msg, or false if msg responds to a method that has not been matched yet.
+func (t *ReplayTransport) replay(msg RecordedMessage) ([]byte, bool, error) {
+	var header messageHeader
+	if err := json.Unmarshal(msg.Data, &header); err != nil {
+		return nil, false, err
+	}
+	if header.ID == nil {
+		return msg.Data, true, nil
+	}
+	m, ok := t.methodsByID[*header.ID]
+	if !ok {
+		// A response to a method sent before the recording started.
+		return msg.Data, true, nil
+	}
+	if !m.matched {
+		return nil, false, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(msg.Data, &fields); err != nil {
+		return nil, false, err
+	}
+	fields["id"] = json.RawMessage(fmt.Sprint(m.liveID))
+	data, err := json.Marshal(fields)
+	return data, err == nil, err
+}
+
+// WriteMessage implements Transport.
+func (t *ReplayTransport) WriteMessage(data []byte) error {
+	var header messageHeader
+	if err := json.Unmarshal(data, &header); err != nil {
+		return err
+	}
+	if header.ID == nil {
+		return errors.New("devtools: method without an ID")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return io.ErrClosedPipe
+	}
+	defer t.cond.Broadcast()
+	for _, m := range t.methods {
+		if !m.matched && m.method == header.Method && m.sessionID == header.SessionID {
+			m.matched = true
+			m.liveID = *header.ID
+			return nil
+		}
+	}
+
+	t.unexpected = append(t.unexpected, fmt.Sprintf("method %v (session %q) is not in the recording", header.Method, header.SessionID))
+	response := Params{
+		"id": *header.ID,
+		"error": Params{
+			"code":    methodNotFoundCode,
+			"message": fmt.Sprintf("'%v' wasn't found in the recording", header.Method),
+		},
+	}
+	if header.SessionID != "" {
+		response["sessionId"] = header.SessionID
+	}
+	responseData, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	t.responses = append(t.responses, responseData)
+	return nil
+}
+
+// Close implements Transport.
+func (t *ReplayTransport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.closed = true
+	t.cond.Broadcast()
+	return nil
+}
+
+// Mismatches describes how the replayed connection diverged from the recording so far: the methods
+// it sent that are not in the recording, followed by the recorded methods it has not sent.
+func (t *ReplayTransport) Mismatches() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	mismatches := append([]string(nil), t.unexpected...)
+	for _, m := range t.methods {
+		if !m.matched {
+			mismatches = append(mismatches, fmt.Sprintf("recorded method %v (session %q) was not sent", m.method, m.sessionID))
+		}
+	}
+	return mismatches
+}
@@ -0,0 +1,162 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devtools
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// session runs the same exchange with Chrome on the connection, and returns the values it got back.
+func session(t *testing.T, connection *Connection) []interface{} {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var got []interface{}
+	connection.InvokeMethod("Page.enable", Params{})
+	connection.InvokeMethod("Page.navigate", Params{"url": "http://example.com/"})
+	for i := 0; i < 2; i++ {
+		event, err := connection.NextEvent()
+		if err != nil {
+			t.Fatalf("NextEvent: %v", err)
+		}
+		got = append(got, event.Method, map[string]interface{}(event.Params))
+	}
+	result, err := connection.Call(ctx, "DOM.getDocument", Params{"depth": -1})
+	if err != nil {
+		t.Fatalf("Call(DOM.getDocument): %v", err)
+	}
+	got = append(got, map[string]interface{}(result))
+	return got
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	server := startFakeChrome(t, func(msg method) []map[string]interface{} {
+		switch msg.Method {
+		case "Page.navigate":
+			return []map[string]interface{}{
+				{"result": map[string]interface{}{"frameId": "frame-1"}},
+				{"method": "Page.frameNavigated", "params": map[string]interface{}{"frame": map[string]interface{}{"id": "frame-1"}}},
+				{"method": "Page.loadEventFired", "params": map[string]interface{}{"timestamp": 1.5}},
+			}
+		case "DOM.getDocument":
+			return []map[string]interface{}{{"result": map[string]interface{}{"root": map[string]interface{}{"nodeId": 1}}}}
+		default:
+			return []map[string]interface{}{{"result": map[string]interface{}{}}}
+		}
+	})
+	defer server.Close()
+
+	connection, err := NewConnection(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatalf("NewConnection: %v", err)
+	}
+	var buf bytes.Buffer
+	connection.Record(&buf)
+	want := session(t, connection)
+	connection.Close()
+
+	recording, err := ReadRecording(&buf)
+	if err != nil {
+		t.Fatalf("ReadRecording: %v", err)
+	}
+	var sent, received int
+	for _, msg := range recording {
+		if msg.Time.IsZero() {
+			t.Errorf("recorded message %s has no timestamp", msg.Data)
+		}
+		if msg.Sent() {
+			sent++
+		} else {
+			received++
+		}
+	}
+	if sent != 3 || received != 5 {
+		t.Fatalf("recorded %v sent and %v received messages, want 3 and 5", sent, received)
+	}
+
+	transport, err := NewReplayTransport(recording)
+	if err != nil {
+		t.Fatalf("NewReplayTransport: %v", err)
+	}
+	replayed := NewConnectionWithTransport(transport)
+	defer replayed.Close()
+
+	// Shift the IDs of the replayed methods from the recorded ones.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = replayed.Call(ctx, "Runtime.evaluate", Params{"expression": "1"})
+	if perr, ok := err.(*ProtocolError); !ok || perr.Code != methodNotFoundCode {
+		t.Errorf("Call(Runtime.evaluate) got error %v, want a method not found *ProtocolError", err)
+	}
+
+	if got := session(t, replayed); !reflect.DeepEqual(got, want) {
+		t.Errorf("replayed session got: %v, want: %v", got, want)
+	}
+
+	mismatches := transport.Mismatches()
+	if len(mismatches) != 1 || !strings.Contains(mismatches[0], "Runtime.evaluate") {
+		t.Errorf("Mismatches() = %v, want only Runtime.evaluate", mismatches)
+	}
+
+	// The recording has ended.
+	if _, err := replayed.NextEvent(); err == nil {
+		t.Errorf("NextEvent after the end of the recording succeeded")
+	}
+}
+
+func TestReplayHoldsResponses(t *testing.T) {
+	recording, err := ReadRecording(strings.NewReader(`
+{"time": "2017-06-01T10:00:00Z", "direction": "send", "data": {"id": 7, "method": "DOM.getDocument", "params": {}}}
+{"time": "2017-06-01T10:00:01Z", "direction": "recv", "data": {"method": "DOM.documentUpdated", "params": {}}}
+{"time": "2017-06-01T10:00:02Z", "direction": "recv", "data": {"id": 7, "result": {"root": {"nodeId": 1}}}}
+`))
+	if err != nil {
+		t.Fatalf("ReadRecording: %v", err)
+	}
+	transport, err := NewReplayTransport(recording)
+	if err != nil {
+		t.Fatalf("NewReplayTransport: %v", err)
+	}
+
+	// Events are replayed right away, but the response waits for its method.
+	data, err := transport.ReadMessage()
+	if err != nil || !strings.Contains(string(data), "DOM.documentUpdated") {
+		t.Fatalf("ReadMessage got: %s, %v, want DOM.documentUpdated", data, err)
+	}
+	read := make(chan []byte)
+	go func() {
+		data, _ := transport.ReadMessage()
+		read <- data
+	}()
+	select {
+	case data := <-read:
+		t.Fatalf("ReadMessage got %s before the method was sent", data)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if err := transport.WriteMessage([]byte(`{"id": 0, "method": "DOM.getDocument", "params": {}}`)); err != nil {
+		t.Fatalf("WriteMessage: %v", err)
+	}
+	if data := <-read; !strings.Contains(string(data), `"id":0`) {
+		t.Errorf("ReadMessage got %s, want the response with the ID of the replayed method", data)
+	}
+	if _, err := transport.ReadMessage(); err != ErrRecordingEnded {
+		t.Errorf("ReadMessage got error %v, want %v", err, ErrRecordingEnded)
+	}
+}
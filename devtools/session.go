@@ -0,0 +1,305 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devtools
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+)
+
+// The time allowed for Chrome to close the target of a Session.
+const closeTargetTimeout = 10 * time.Second
+
+// ErrDetached is returned by the methods of a Session after its target has been closed or detached.
+var ErrDetached = errors.New("devtools: session detached")
+
+// Target controls one target of Chrome, e.g. a tab. It is implemented by a Connection to the
+// target's own endpoint and by a Session attached through a browser-level Connection.
+type Target interface {
+	InvokeMethod(methodName string, params Params)
+	InvokeMethodAndGetReturn(methodName string, params Params) Result
+	Call(ctx context.Context, methodName string, params Params) (Params, error)
+	NextEvent() (EventMessage, error)
+	NextEventContext(ctx context.Context) (EventMessage, error)
+	Subscribe(methodPattern string) (<-chan EventMessage, func())
+	DiscardEvents()
+	SetEventQueueHighWaterMark(highWaterMark int, onOverflow func(EventQueueStats))
+	EventQueueStats() EventQueueStats
+}
+
+var (
+	_ Target = (*Connection)(nil)
+	_ Target = (*Session)(nil)
+)
+
+// Session controls one target, e.g. a tab, attached through a browser-level Connection. Sessions
+// share the websocket of their Connection: methods are tagged with the ID of the session, and
+// only the events tagged with that ID are delivered to the session.
+type Session struct {
+	ID       string // The sessionId assigned by Chrome.
+	TargetID string // The target the session is attached to.
+
+	conn   *Connection
+	events *eventStream
+	done   chan struct{} // Closed once the session has ended.
+
+	mu  sync.Mutex // Protects err.
+	err error      // The reason the session ended. Only set once done is closed.
+}
+
+// CreateBrowserContext creates an isolated browser context, similar to an incognito profile.
+// Targets created in different browser contexts share no cookies, storage or cache.
+func (c *Connection) CreateBrowserContext(ctx context.Context) (string, error) {
+	result, err := c.Call(ctx, "Target.createBrowserContext", Params{})
+	if err != nil {
+		return "", err
+	}
+	browserContextID, ok := result.String("browserContextId")
+	if !ok {
+		return "", errors.New("malformed response. Missing \"browserContextId\" attribute")
+	}
+	return browserContextID, nil
+}
+
+// DisposeBrowserContext closes all targets of the browser context and deletes its data.
+func (c *Connection) DisposeBrowserContext(ctx context.Context, browserContextID string) error {
+	_, err := c.Call(ctx, "Target.disposeBrowserContext", Params{"browserContextId": browserContextID})
+	return err
+}
+
+// CreateTarget opens a new tab navigated to url and returns its target ID. If browserContextID
+// is empty, the tab is created in the default browser context.
+func (c *Connection) CreateTarget(ctx context.Context, url, browserContextID string) (string, error) {
+	params := Params{"url": url}
+	if browserContextID != "" {
+		params["browserContextId"] = browserContextID
+	}
+	result, err := c.Call(ctx, "Target.createTarget", params)
+	if err != nil {
+		return "", err
+	}
+	targetID, ok := result.String("targetId")
+	if !ok {
+		return "", errors.New("malformed response. Missing \"targetId\" attribute")
+	}
+	return targetID, nil
+}
+
+// AttachToTarget attaches to the target in flattened mode and returns the Session controlling it.
+func (c *Connection) AttachToTarget(ctx context.Context, targetID string) (*Session, error) {
+	result, err := c.Call(ctx, "Target.attachToTarget", Params{
+		"targetId": targetID,
+		"flatten":  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	sessionID, ok := result.String("sessionId")
+	if !ok {
+		return nil, errors.New("malformed response. Missing \"sessionId\" attribute")
+	}
+
+	s := &Session{
+		ID:       sessionID,
+		TargetID: targetID,
+		conn:     c,
+		events:   newEventStream(),
+		done:     make(chan struct{}),
+	}
+	c.sessionsMutex.Lock()
+	defer c.sessionsMutex.Unlock()
+	if c.sessions == nil {
+		// The connection has already ended.
+		s.end(c.Err())
+		return nil, c.Err()
+	}
+	c.sessions[sessionID] = s
+	return s, nil
+}
+
+// NewTab creates a blank tab in the browser context and returns the Session controlling it.
+// If browserContextID is empty, the tab is created in the default browser context.
+func (c *Connection) NewTab(ctx context.Context, browserContextID string) (*Session, error) {
+	targetID, err := c.CreateTarget(ctx, "about:blank", browserContextID)
+	if err != nil {
+		return nil, err
+	}
+	return c.AttachToTarget(ctx, targetID)
+}
+
+// AttachToPage attaches to the first tab listed by the browser, or to a new blank tab in the default
+// browser context if there is none, and returns the Session controlling it.
+func (c *Connection) AttachToPage(ctx context.Context) (*Session, error) {
+	result, err := c.Call(ctx, "Target.getTargets", Params{})
+	if err != nil {
+		return nil, err
+	}
+	targetInfos, _ := result["targetInfos"].([]interface{})
+	for _, info := range targetInfos {
+		m, ok := info.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		targetInfo := Params(m)
+		if targetType, _ := targetInfo.String("type"); targetType != TabType {
+			continue
+		}
+		if targetID, ok := targetInfo.String("targetId"); ok {
+			return c.AttachToTarget(ctx, targetID)
+		}
+	}
+	return c.NewTab(ctx, "")
+}
+
+// dispatchEvent routes the event to the session it is tagged with, or to the connection itself.
+func (c *Connection) dispatchEvent(event EventMessage) {
+	if event.SessionID != "" {
+		c.sessionsMutex.Lock()
+		s, ok := c.sessions[event.SessionID]
+		c.sessionsMutex.Unlock()
+		if ok {
+			s.events.dispatch(event)
+		}
+		// Events of sessions that are not, or no longer, attached are dropped.
+		return
+	}
+
+	c.events.dispatch(event)
+	if event.Method == "Target.detachedFromTarget" {
+		if sessionID, ok := event.Params.String("sessionId"); ok {
+			c.endSession(sessionID, ErrDetached)
+		}
+	}
+}
+
+// endSession ends the session with the given ID, if it is attached.
+func (c *Connection) endSession(sessionID string, err error) {
+	c.sessionsMutex.Lock()
+	s, ok := c.sessions[sessionID]
+	delete(c.sessions, sessionID)
+	c.sessionsMutex.Unlock()
+	if ok {
+		s.end(err)
+	}
+}
+
+// endSessions ends all sessions once the connection has ended.
+func (c *Connection) endSessions() {
+	c.sessionsMutex.Lock()
+	sessions := c.sessions
+	c.sessions = nil
+	c.sessionsMutex.Unlock()
+	for _, s := range sessions {
+		s.end(c.Err())
+	}
+}
+
+// end ends the session with err. Only the first call has an effect.
+func (s *Session) end(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return
+	}
+	s.err = err
+	close(s.done)
+	s.events.close()
+}
+
+// Close closes the target of the session, which also detaches the session.
+func (s *Session) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), closeTargetTimeout)
+	defer cancel()
+	_, err := s.conn.Call(ctx, "Target.closeTarget", Params{"targetId": s.TargetID})
+	s.conn.endSession(s.ID, ErrDetached)
+	return err
+}
+
+// Done returns a channel that is closed when the session has ended, either because its
+// target was closed or detached, or because its Connection ended.
+func (s *Session) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns nil while the session is attached. Once Done is closed, Err returns
+// ErrDetached if the target was closed or detached, or the reason the Connection ended.
+func (s *Session) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// InvokeMethod invokes the specified method on the target of the session. Doesn't wait for a response.
+func (s *Session) InvokeMethod(methodName string, params Params) {
+	s.conn.invokeMethod(s.ID, methodName, params)
+}
+
+// InvokeMethodAndGetReturn invokes the specified method on the target of the session and returns Chrome's response.
+// This blocks until Chrome responds; prefer Call, which can be cancelled.
+func (s *Session) InvokeMethodAndGetReturn(methodName string, params Params) Result {
+	return s.conn.invokeMethodAndGetReturn(s.ID, methodName, params)
+}
+
+// Call invokes the specified method on the target of the session and waits for its return value.
+// Errors are reported as by Connection.Call.
+func (s *Session) Call(ctx context.Context, methodName string, params Params) (Params, error) {
+	return s.conn.call(ctx, s.ID, methodName, params)
+}
+
+// NextEvent returns the next event of the session. Once all events have been returned, it returns io.EOF
+// if the session was detached or its Connection closed by Close, or the error that ended the Connection.
+func (s *Session) NextEvent() (EventMessage, error) {
+	return s.NextEventContext(context.Background())
+}
+
+// NextEventContext is like NextEvent, but returns ctx.Err() if ctx is done while waiting for an event.
+func (s *Session) NextEventContext(ctx context.Context) (EventMessage, error) {
+	retval, ok := s.events.queue.pop(ctx.Done())
+	if !ok {
+		if err := ctx.Err(); err != nil {
+			return retval, err
+		}
+		if err := s.Err(); err != nil && err != ErrDetached && err != ErrClosed {
+			return retval, err
+		}
+		return retval, io.EOF
+	}
+	return retval, nil
+}
+
+// Subscribe returns a channel receiving the events of the session whose method matches methodPattern.
+// See Connection.Subscribe.
+func (s *Session) Subscribe(methodPattern string) (<-chan EventMessage, func()) {
+	return s.events.subscribe(methodPattern)
+}
+
+// DiscardEvents stops keeping the events of the session for NextEvent. See Connection.DiscardEvents.
+func (s *Session) DiscardEvents() {
+	s.events.discardEvents()
+}
+
+// SetEventQueueHighWaterMark sets the number of unprocessed events of the session above which onOverflow is called.
+// See Connection.SetEventQueueHighWaterMark.
+func (s *Session) SetEventQueueHighWaterMark(highWaterMark int, onOverflow func(EventQueueStats)) {
+	s.events.queue.setHighWaterMark(highWaterMark, onOverflow)
+}
+
+// EventQueueStats returns statistics on the events of the session received but not yet returned by NextEvent.
+func (s *Session) EventQueueStats() EventQueueStats {
+	return s.events.queue.snapshot()
+}
@@ -0,0 +1,168 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devtools
+
+import (
+	"strings"
+	"sync"
+)
+
+// subscription delivers the events matching pattern to a single subscriber.
+type subscription struct {
+	pattern string
+	events  *eventQueue // Buffers matching events so that dispatching never blocks.
+	out     chan EventMessage
+	done    chan struct{} // Closed when the subscriber cancels.
+	once    sync.Once
+}
+
+// matchesPattern returns whether the event method matches the pattern. A pattern is either
+// an exact method name such as "Page.loadEventFired", a domain wildcard such as "DOM.*", or "*".
+func matchesPattern(pattern, method string) bool {
+	if pattern == "*" || pattern == method {
+		return true
+	}
+	if strings.HasSuffix(pattern, ".*") {
+		return strings.HasPrefix(method, pattern[:len(pattern)-1])
+	}
+	return false
+}
+
+// forward moves events from the queue of the subscription to its channel until the
+// queue is closed and drained, or the subscriber cancels.
+func (s *subscription) forward() {
+	defer close(s.out)
+	for {
+		event, ok := s.events.pop(nil)
+		if !ok {
+			return
+		}
+		select {
+		case s.out <- event:
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// cancel stops the delivery of events to the subscriber.
+func (s *subscription) cancel() {
+	s.once.Do(func() {
+		close(s.done)
+		s.events.close()
+	})
+}
+
+// eventStream delivers the events of a connection or session to NextEvent and to subscribers.
+type eventStream struct {
+	queue *eventQueue // Unbounded buffer holding events until NextEvent returns them.
+
+	mu                 sync.Mutex // Protects the following fields.
+	subscribers        map[int]*subscription
+	nextSubscriptionID int
+	discarding         bool // Whether events are no longer kept for NextEvent, see DiscardEvents.
+	closed             bool // Whether no more events will be dispatched.
+}
+
+// newEventStream creates an eventStream without subscribers.
+func newEventStream() *eventStream {
+	return &eventStream{
+		queue:       newEventQueue(DefaultEventQueueHighWaterMark),
+		subscribers: make(map[int]*subscription),
+	}
+}
+
+// subscribe implements Subscribe for connections and sessions.
+func (es *eventStream) subscribe(methodPattern string) (<-chan EventMessage, func()) {
+	s := &subscription{
+		pattern: methodPattern,
+		events:  newEventQueue(DefaultEventQueueHighWaterMark),
+		out:     make(chan EventMessage),
+		done:    make(chan struct{}),
+	}
+	go s.forward()
+
+	es.mu.Lock()
+	if es.closed {
+		es.mu.Unlock()
+		s.cancel()
+		return s.out, func() {}
+	}
+	id := es.nextSubscriptionID
+	es.nextSubscriptionID++
+	es.subscribers[id] = s
+	es.mu.Unlock()
+
+	return s.out, func() {
+		es.mu.Lock()
+		delete(es.subscribers, id)
+		es.mu.Unlock()
+		s.cancel()
+	}
+}
+
+// discardEvents stops keeping events for NextEvent, and drops the ones already kept.
+func (es *eventStream) discardEvents() {
+	es.mu.Lock()
+	es.discarding = true
+	es.mu.Unlock()
+	es.queue.drain()
+}
+
+// dispatch adds the event to the buffer for NextEvent, unless it is discarded, and hands it to every subscriber
+// whose pattern matches. This never blocks, however slowly events are consumed.
+func (es *eventStream) dispatch(event EventMessage) {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	if es.discarding {
+		es.queue.discard()
+	} else {
+		es.queue.push(event)
+	}
+	for _, s := range es.subscribers {
+		if matchesPattern(s.pattern, event.Method) {
+			s.events.push(event)
+		}
+	}
+}
+
+// close closes the channels of all subscribers and ends NextEvent once no more events will be dispatched.
+// Events already dispatched can still be read.
+func (es *eventStream) close() {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	es.closed = true
+	for id, s := range es.subscribers {
+		// Close only the queue so that subscribers can still read the events already received.
+		s.events.close()
+		delete(es.subscribers, id)
+	}
+	es.queue.close()
+}
+
+// Subscribe returns a channel receiving every event whose method matches methodPattern, which
+// is either an exact method name such as "Page.loadEventFired" or a domain wildcard such as "DOM.*".
+// Each subscriber receives its own copy of the events, in the order they were received, and events
+// are buffered without bound until they are read. The channel is closed when cancel is called or
+// the connection ends. Subscribing does not remove events from NextEvent.
+func (c *Connection) Subscribe(methodPattern string) (<-chan EventMessage, func()) {
+	return c.events.subscribe(methodPattern)
+}
+
+// DiscardEvents stops keeping events for NextEvent, e.g. on connections only read through Subscribe,
+// so that they do not accumulate events nobody reads. The events already kept are dropped.
+func (c *Connection) DiscardEvents() {
+	c.events.discardEvents()
+}
@@ -0,0 +1,115 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devtools
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// The time allowed for sending a control message, e.g. close, on a websocket.
+const closeMessageTimeout = 10 * time.Second
+
+// Transport carries the JSON messages of a Connection to and from Chrome.
+// ReadMessage and WriteMessage are each called from a single goroutine, but concurrently with one another.
+type Transport interface {
+	// ReadMessage blocks until the next message from Chrome is received.
+	ReadMessage() ([]byte, error)
+	// WriteMessage sends a message to Chrome.
+	WriteMessage(data []byte) error
+	// Close closes the transport. Pending and later calls to ReadMessage and WriteMessage fail.
+	Close() error
+}
+
+// webSocketTransport is the Transport of a Connection to a devtools websocket endpoint.
+type webSocketTransport struct {
+	sock *websocket.Conn
+
+	mu       sync.Mutex               // Protects the following fields.
+	nextPing int                      // Used to give each ping a unique payload.
+	pongs    map[string]chan struct{} // Closed when the pong of the ping with the payload is received, keyed by payload.
+}
+
+// newWebSocketTransport returns the Transport of a Connection over sock.
+func newWebSocketTransport(sock *websocket.Conn) *webSocketTransport {
+	t := &webSocketTransport{
+		sock:  sock,
+		pongs: make(map[string]chan struct{}),
+	}
+	// Pongs are handled while ReadMessage is waiting for a message.
+	sock.SetPongHandler(t.handlePong)
+	return t
+}
+
+// ReadMessage implements Transport.
+func (t *webSocketTransport) ReadMessage() ([]byte, error) {
+	_, data, err := t.sock.ReadMessage()
+	return data, err
+}
+
+// WriteMessage implements Transport.
+func (t *webSocketTransport) WriteMessage(data []byte) error {
+	return t.sock.WriteMessage(websocket.TextMessage, data)
+}
+
+// Ping sends a websocket ping to Chrome, and waits for its pong until ctx is done.
+func (t *webSocketTransport) Ping(ctx context.Context) error {
+	t.mu.Lock()
+	payload := strconv.Itoa(t.nextPing)
+	t.nextPing++
+	pong := make(chan struct{})
+	t.pongs[payload] = pong
+	t.mu.Unlock()
+	defer func() {
+		t.mu.Lock()
+		delete(t.pongs, payload)
+		t.mu.Unlock()
+	}()
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(closeMessageTimeout)
+	}
+	if err := t.sock.WriteControl(websocket.PingMessage, []byte(payload), deadline); err != nil {
+		return err
+	}
+	select {
+	case <-pong:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// handlePong notifies the ping waiting for the pong with the payload, if any.
+func (t *webSocketTransport) handlePong(payload string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if pong, ok := t.pongs[payload]; ok {
+		close(pong)
+		delete(t.pongs, payload)
+	}
+	return nil
+}
+
+// Close sends a close control message to Chrome, then closes the websocket.
+func (t *webSocketTransport) Close() error {
+	t.sock.WriteControl(websocket.CloseMessage, []byte{}, time.Now().Add(closeMessageTimeout))
+	return t.sock.Close()
+}
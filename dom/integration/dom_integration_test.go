@@ -25,10 +25,12 @@ import (
 	"github.com/phayes/freeport"
 
 	"streaming_hdp/chrome"
+	"streaming_hdp/devtools/cdptest"
 	"streaming_hdp/dom"
 )
 
 func TestGenerateDOM(t *testing.T) {
+	cdptest.RequireChrome(t)
 	// This spins up Chrome and have it navigate to a test server.
 	tests := []struct {
 		dom                string
@@ -83,7 +85,7 @@ func TestGenerateDOM(t *testing.T) {
 				pageStabilized := false
 				for {
 					event, err := chromeInstance.NextEvent()
-					if err == io.EOF {
+					if err != nil {
 						// no more events to process.
 						break
 					}
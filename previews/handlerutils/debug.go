@@ -0,0 +1,98 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlerutils
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"streaming_hdp/chrome"
+)
+
+// DebugInstancesPath is the path the proxies serve the state of their Chrome instances at, when enabled.
+const DebugInstancesPath = "/debug/instances"
+
+var debugInstancesTemplate = template.Must(template.New("instances").Funcs(template.FuncMap{
+	"round": func(d time.Duration) time.Duration { return d.Round(time.Millisecond) },
+	"since": func(t time.Time) string {
+		if t.IsZero() {
+			return "never"
+		}
+		return time.Since(t).Round(time.Millisecond).String() + " ago"
+	},
+}).Parse(`<!DOCTYPE html>
+<html>
+<head><title>Chrome instances</title></head>
+<body>
+<p>{{.Idle}} idle, {{.InUse}} in use, {{.Starting}} starting, {{.Waiting}} waiting for an instance, {{.LaunchFailures}} failed to start{{if .Closed}}, closed{{end}}.</p>
+<table border="1">
+<tr><th>ID</th><th>Port</th><th>PID</th><th>State</th><th>URL</th><th>Age</th><th>Last event</th><th>Pages</th><th>Healthy</th><th>Error</th></tr>
+{{range .Instances}}<tr><td>{{.ID}}</td><td>{{if .Remote}}{{.Remote}}{{else if .Port}}{{.Port}}{{else}}pipe{{end}}</td><td>{{if .PID}}{{.PID}}{{end}}</td><td>{{.State}}</td><td>{{.URL}}</td><td>{{round .Age}}</td><td>{{since .LastEvent}}</td><td>{{.Navigations}}</td><td>{{.Healthy}}</td><td>{{.Err}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+// DebugInstances returns a handler serving the Stats of the manager, as JSON if the request asks for it
+// with ?format=json or its Accept header, and as an HTML table otherwise. The URLs being rendered are
+// listed: the handler must only be reachable by the operators of the proxy.
+func DebugInstances(manager *chrome.InstanceManager) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		stats := manager.Stats()
+		rw.Header().Set("Cache-Control", "no-store")
+		if req.URL.Query().Get("format") == "json" || strings.Contains(req.Header.Get("Accept"), "application/json") {
+			rw.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(rw).Encode(stats); err != nil {
+				fmt.Printf("failed to write the stats of the chrome instances: %v\n", err)
+			}
+			return
+		}
+		rw.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := debugInstancesTemplate.Execute(rw, stats); err != nil {
+			fmt.Printf("failed to write the stats of the chrome instances: %v\n", err)
+		}
+	})
+}
+
+// ServeDebug serves DebugInstances at DebugInstancesPath of addr, in the background until the returned
+// server is closed. The pages it serves list the URLs being rendered, so addr must be bound to localhost,
+// apart from the listener of the proxy, e.g. "localhost:8081".
+func ServeDebug(addr string, manager *chrome.InstanceManager) (*http.Server, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return nil, fmt.Errorf("the debug pages must be served on localhost, not %v", addr)
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(DebugInstancesPath, DebugInstances(manager))
+	server := &http.Server{Addr: listener.Addr().String(), Handler: mux}
+	go func() {
+		if err := server.Serve(listener); err != http.ErrServerClosed {
+			fmt.Printf("debug server failed: %v\n", err)
+		}
+	}()
+	return server, nil
+}
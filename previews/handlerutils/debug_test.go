@@ -0,0 +1,127 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlerutils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"streaming_hdp/chrome"
+	"streaming_hdp/devtools"
+	"streaming_hdp/devtools/cdptest"
+)
+
+// fakeBackend connects the instances to a fake Chrome.
+type fakeBackend struct {
+	s *cdptest.Server
+}
+
+func (b fakeBackend) Launch() (*chrome.Instance, error) {
+	conn, err := devtools.NewConnection(b.s.HostPort())
+	if err != nil {
+		return nil, err
+	}
+	return chrome.Attach(conn), nil
+}
+
+func (b fakeBackend) Close() error {
+	return nil
+}
+
+func TestDebugInstances(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	manager := chrome.NewInstanceManagerWithBackend(fakeBackend{s})
+	manager.SetPool(chrome.PoolOptions{MinIdle: 1, MaxIdle: 1, AcquireTimeout: 5 * time.Second})
+	defer func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		manager.Close(ctx)
+	}()
+	if _, err := manager.AcquireInstance("http://example.com/<page>"); err != nil {
+		t.Fatalf("AcquireInstance: %v", err)
+	}
+	handler := DebugInstances(manager)
+
+	req := httptest.NewRequest("GET", DebugInstancesPath+"?format=json", nil)
+	rw := httptest.NewRecorder()
+	handler.ServeHTTP(rw, req)
+	if got := rw.Header().Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", got)
+	}
+	var stats chrome.Stats
+	if err := json.NewDecoder(rw.Body).Decode(&stats); err != nil {
+		t.Fatalf("failed to decode the stats: %v", err)
+	}
+	if stats.InUse != 1 || len(stats.Instances) == 0 || stats.Instances[0].URL != "http://example.com/<page>" {
+		t.Errorf("stats = %+v, want the instance in use for http://example.com/<page>", stats)
+	}
+
+	req = httptest.NewRequest("GET", DebugInstancesPath, nil)
+	req.Header.Set("Accept", "text/html")
+	rw = httptest.NewRecorder()
+	handler.ServeHTTP(rw, req)
+	if rw.Code != http.StatusOK {
+		t.Fatalf("status = %v, want %v", rw.Code, http.StatusOK)
+	}
+	body := rw.Body.String()
+	if !strings.Contains(body, "http://example.com/&lt;page&gt;") || !strings.Contains(body, string(chrome.InstanceInUse)) {
+		t.Errorf("the HTML page does not list the instance in use, escaped:\n%v", body)
+	}
+}
+
+func TestServeDebug(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	manager := chrome.NewInstanceManagerWithBackend(fakeBackend{s})
+	defer func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		manager.Close(ctx)
+	}()
+
+	for _, addr := range []string{":0", "0.0.0.0:0", "example.com:8081", "localhost"} {
+		if server, err := ServeDebug(addr, manager); err == nil {
+			server.Close()
+			t.Errorf("ServeDebug(%q) succeeded, want the debug pages only served on localhost", addr)
+		}
+	}
+
+	server, err := ServeDebug("127.0.0.1:0", manager)
+	if err != nil {
+		t.Fatalf("ServeDebug: %v", err)
+	}
+	defer server.Close()
+	response, err := http.Get("http://" + server.Addr + DebugInstancesPath + "?format=json")
+	if err != nil {
+		t.Fatalf("GET %v: %v", DebugInstancesPath, err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK || response.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("GET %v: status %v, Content-Type %q, want the stats as JSON", DebugInstancesPath, response.StatusCode, response.Header.Get("Content-Type"))
+	}
+	if response, err = http.Get("http://" + server.Addr + "/"); err != nil {
+		t.Fatalf("GET /: %v", err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusNotFound {
+		t.Errorf("GET /: status %v, want only the debug pages served", response.StatusCode)
+	}
+}
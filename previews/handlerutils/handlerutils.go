@@ -16,10 +16,16 @@
 package handlerutils
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
+	"net/http/httputil"
+	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/phayes/freeport"
@@ -39,9 +45,89 @@ func IsEventHandler(s string) bool {
 	return strings.HasPrefix(s, "on")
 }
 
-// IsDocument checks if the response is a document by using the MIME type.
+// sniffLen is the number of bytes of content considered by http.DetectContentType.
+const sniffLen = 512
+
+// IsDocument checks if the response is a document by using the MIME type, or if the response has
+// none, by sniffing its content. The content sniffed is put back in front of the body.
 func IsDocument(response *http.Response) bool {
-	return strings.Contains(response.Header.Get("Content-Type"), "text/html")
+	contentType := response.Header.Get("Content-Type")
+	if contentType == "" && response.Body != nil {
+		sniffed := make([]byte, sniffLen)
+		n, _ := io.ReadFull(response.Body, sniffed)
+		sniffed = sniffed[:n]
+		response.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(sniffed), response.Body), response.Body}
+		contentType = http.DetectContentType(sniffed)
+	}
+	return isDocumentType(contentType)
+}
+
+// isDocumentType returns whether the MIME type, or Content-Type, is the one of an HTML document.
+func isDocumentType(contentType string) bool {
+	return strings.Contains(contentType, "text/html") || strings.Contains(contentType, "application/xhtml+xml")
+}
+
+// FallbackHeader is set on the responses serving the original page instead of its preview. Its value is why.
+const FallbackHeader = "X-Preview-Fallback"
+
+// ServeFallback serves the original page through rp instead of its preview, e.g. because rendering the
+// page exceeded its resource budget.
+func ServeFallback(rw http.ResponseWriter, req *http.Request, rp *httputil.ReverseProxy, reason string) {
+	rw.Header().Set(FallbackHeader, reason)
+	rp.ServeHTTP(rw, req)
+}
+
+// OriginHeaders are the headers of the responses to the requests of pages propagated to their previews.
+var OriginHeaders = []string{"Cache-Control", "Set-Cookie", "Vary"}
+
+// CopyOriginHeaders adds the OriginHeaders of the response to the request of a page to header, e.g.
+// the headers of its preview.
+func CopyOriginHeaders(header http.Header, response *chrome.MainResponse) {
+	for _, name := range OriginHeaders {
+		for _, value := range response.Header.Values(name) {
+			header.Add(name, value)
+		}
+	}
+}
+
+// StabilityHeader selects how the page requested is deemed stable, overriding the default of the proxy.
+// Its value is in the syntax of chrome.ParseStability, e.g. "network-idle=500ms|max=10s".
+const StabilityHeader = "X-Preview-Stability"
+
+// RequestStability returns the stability condition selected by the request with StabilityHeader, or
+// nil if it selects none.
+func RequestStability(req *http.Request) (chrome.StabilityCondition, error) {
+	spec := req.Header.Get(StabilityHeader)
+	if spec == "" {
+		return nil, nil
+	}
+	return chrome.ParseStability(spec)
+}
+
+// LogInterceptions logs the requests of the page blocked, rewritten or mocked by the Chrome instance rendering it.
+func LogInterceptions(page string, chromeInstance *chrome.Instance) {
+	interceptions := chromeInstance.Interceptions()
+	if len(interceptions) == 0 {
+		return
+	}
+	fmt.Printf("intercepted %v requests of %v:\n", len(interceptions), page)
+	for _, interception := range interceptions {
+		fmt.Printf("  %v %v %v (%v)\n", interception.Action, interception.ResourceType, interception.URL, interception.Rule)
+	}
+}
+
+// TerminationStatus returns the status code of the response to a request whose Chrome instance
+// terminated with err before the preview was ready.
+func TerminationStatus(err error) int {
+	switch err {
+	case chrome.ErrRequestTimeout, chrome.ErrIdleTimeout:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusBadGateway
+	}
 }
 
 // Passthrough writes the HTTP response back to the http.ResponseWrite.
@@ -72,3 +158,34 @@ func CreateChromeInstance(useFullChrome bool) (*chrome.Instance, error) {
 	}
 	return chromeInstance, nil
 }
+
+// ServeTLS serves HTTPS requests with server until it fails, or the process receives SIGINT or SIGTERM.
+// It then shuts server down, waiting at most shutdownTimeout for the requests in flight and the Chrome
+// instances in use, and terminates the Chrome instances of the manager.
+func ServeTLS(server *http.Server, certFile, keyFile string, manager *chrome.InstanceManager, shutdownTimeout time.Duration) error {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.ListenAndServeTLS(certFile, keyFile)
+	}()
+
+	var err error
+	select {
+	case err = <-serveErr:
+		fmt.Printf("server failed: %v\n", err)
+	case sig := <-signals:
+		fmt.Printf("received %v, shutting down\n", sig)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if shutdownErr := server.Shutdown(ctx); shutdownErr != nil {
+		fmt.Printf("failed to wait for the requests in flight: %v\n", shutdownErr)
+	}
+	if closeErr := manager.Close(ctx); closeErr != nil {
+		fmt.Printf("failed to wait for the chrome instances in use: %v\n", closeErr)
+	}
+	return err
+}
@@ -0,0 +1,51 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlerutils
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIsDocument(t *testing.T) {
+	testCases := []struct {
+		name        string
+		contentType string
+		body        string
+		want        bool
+	}{
+		{"html", "text/html; charset=utf-8", "", true},
+		{"json", "application/json", "<html></html>", false},
+		{"sniffed_html", "", "<!DOCTYPE html><html><body>hello</body></html>", true},
+		{"sniffed_pdf", "", "%PDF-1.4", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			response := &http.Response{Header: http.Header{}, Body: ioutil.NopCloser(strings.NewReader(tc.body))}
+			if tc.contentType != "" {
+				response.Header.Set("Content-Type", tc.contentType)
+			}
+			if got := IsDocument(response); got != tc.want {
+				t.Errorf("IsDocument() = %v, want %v", got, tc.want)
+			}
+			// Sniffing must not consume the body.
+			if body, _ := ioutil.ReadAll(response.Body); string(body) != tc.body {
+				t.Errorf("the body after IsDocument() = %q, want %q", body, tc.body)
+			}
+		})
+	}
+}
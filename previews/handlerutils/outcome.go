@@ -0,0 +1,98 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlerutils
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+
+	"streaming_hdp/chrome"
+)
+
+// PreviewQuery is the query parameter tagging the requests for the preview of a page.
+const PreviewQuery = "req_for_preview"
+
+// Outcome is how the request for the preview of a page is answered, given the response to the request of the page.
+type Outcome int
+
+const (
+	// OutcomePreview serves the preview of the page, an HTML document.
+	OutcomePreview Outcome = iota
+	// OutcomeRedirect passes the first redirect of the page back to the client, tagged for preview.
+	OutcomeRedirect
+	// OutcomeError serves the HTTP error of the page as is.
+	OutcomeError
+	// OutcomeNotDocument serves the page as is, since it is not an HTML document, e.g. a PDF or an image.
+	OutcomeNotDocument
+)
+
+// Classify returns how the request for the preview of the page whose request got response is answered.
+// The MIME type of the response is the one Chrome settled on, which it sniffs from the content when the
+// page has no Content-Type.
+func Classify(response *chrome.MainResponse) Outcome {
+	switch {
+	case len(response.Redirects) > 0:
+		return OutcomeRedirect
+	case response.Status >= 400:
+		return OutcomeError
+	case !isDocumentType(response.MIMEType) && !isDocumentType(response.Header.Get("Content-Type")):
+		return OutcomeNotDocument
+	default:
+		return OutcomePreview
+	}
+}
+
+// ServeWithoutPreview answers the request for the preview of the page whose request got response, if
+// the page has no preview: its redirects are passed back to the client, and the other pages are served
+// as is through rp. Returns whether the request was answered.
+//
+// Serving a page through rp fetches it from the origin a second time, since Chrome does not keep the
+// body of pages it downloads instead of rendering, e.g. PDFs. The client then gets the response of
+// the second fetch, which can differ from the one the page was classified on if the origin changed.
+func ServeWithoutPreview(rw http.ResponseWriter, req *http.Request, rp *httputil.ReverseProxy, response *chrome.MainResponse) bool {
+	outcome := Classify(response)
+	if outcome != OutcomePreview {
+		fmt.Printf("serving %v without preview, its response is a %v %v\n", req.URL, response.Status, response.MIMEType)
+	}
+	switch outcome {
+	case OutcomeRedirect:
+		ServeRedirect(rw, req, response.Redirects[0])
+	case OutcomeError:
+		ServeFallback(rw, req, rp, "http-error")
+	case OutcomeNotDocument:
+		ServeFallback(rw, req, rp, "not-document")
+	default:
+		return false
+	}
+	return true
+}
+
+// ServeRedirect answers the request for the preview of a page with the redirect of the page, to the
+// preview of the page redirected to.
+func ServeRedirect(rw http.ResponseWriter, req *http.Request, redirect *chrome.MainResponse) {
+	location, err := req.URL.Parse(redirect.Header.Get("Location"))
+	if err != nil || redirect.Header.Get("Location") == "" {
+		rw.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	// Cross-origin redirects, e.g. to log in, are tagged too: the proxy serves all origins.
+	queries := location.Query()
+	queries.Set(PreviewQuery, req.URL.Query().Get(PreviewQuery))
+	location.RawQuery = queries.Encode()
+	CopyOriginHeaders(rw.Header(), redirect)
+	rw.Header().Set("Location", location.String())
+	rw.WriteHeader(redirect.Status)
+}
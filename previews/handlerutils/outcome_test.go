@@ -0,0 +1,99 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlerutils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"testing"
+
+	"streaming_hdp/chrome"
+)
+
+func TestClassify(t *testing.T) {
+	testCases := []struct {
+		name     string
+		response chrome.MainResponse
+		want     Outcome
+	}{
+		{"html", chrome.MainResponse{Status: 200, MIMEType: "text/html"}, OutcomePreview},
+		{"xhtml", chrome.MainResponse{Status: 200, Header: http.Header{"Content-Type": {"application/xhtml+xml; charset=utf-8"}}}, OutcomePreview},
+		{"redirect", chrome.MainResponse{Status: 200, MIMEType: "text/html", Redirects: []*chrome.MainResponse{{Status: 301}}}, OutcomeRedirect},
+		{"not_found", chrome.MainResponse{Status: 404, MIMEType: "text/html"}, OutcomeError},
+		{"pdf", chrome.MainResponse{Status: 200, MIMEType: "application/pdf"}, OutcomeNotDocument},
+		{"json", chrome.MainResponse{Status: 200, MIMEType: "application/json"}, OutcomeNotDocument},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Classify(&tc.response); got != tc.want {
+				t.Errorf("Classify() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestServeRedirect(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/old?"+PreviewQuery+"=1", nil)
+	for location, want := range map[string]string{
+		"/new?a=b":                   "http://example.com/new?a=b&" + PreviewQuery + "=1",
+		"https://login.example.org/": "https://login.example.org/?" + PreviewQuery + "=1",
+	} {
+		rw := httptest.NewRecorder()
+		ServeRedirect(rw, req, &chrome.MainResponse{
+			Status: http.StatusFound,
+			Header: http.Header{"Location": {location}, "Set-Cookie": {"a=1"}},
+		})
+		if rw.Code != http.StatusFound {
+			t.Errorf("the redirect to %v has the status %v, want %v", location, rw.Code, http.StatusFound)
+		}
+		if got := rw.Header().Get("Location"); got != want {
+			t.Errorf("the redirect to %v is to %v, want %v", location, got, want)
+		}
+		if got := rw.Header().Get("Set-Cookie"); got != "a=1" {
+			t.Errorf("the redirect to %v sets the cookie %q, want a=1", location, got)
+		}
+	}
+
+	rw := httptest.NewRecorder()
+	ServeRedirect(rw, req, &chrome.MainResponse{Status: http.StatusFound, Header: http.Header{}})
+	if rw.Code != http.StatusBadGateway {
+		t.Errorf("the redirect without Location has the status %v, want %v", rw.Code, http.StatusBadGateway)
+	}
+}
+
+func TestServeWithoutPreview(t *testing.T) {
+	origin := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "application/pdf")
+		rw.Write([]byte("%PDF-1.4"))
+	}))
+	defer origin.Close()
+	target, _ := url.Parse(origin.URL)
+	rp := httputil.NewSingleHostReverseProxy(target)
+	req := httptest.NewRequest("GET", origin.URL+"/doc.pdf?"+PreviewQuery, nil)
+
+	rw := httptest.NewRecorder()
+	if !ServeWithoutPreview(rw, req, rp, &chrome.MainResponse{Status: 200, MIMEType: "application/pdf"}) {
+		t.Fatalf("ServeWithoutPreview() of a PDF = false, want true")
+	}
+	if rw.Body.String() != "%PDF-1.4" || rw.Header().Get(FallbackHeader) != "not-document" {
+		t.Errorf("ServeWithoutPreview() of a PDF served %q with %v: %q, want the PDF", rw.Body, FallbackHeader, rw.Header().Get(FallbackHeader))
+	}
+	rw = httptest.NewRecorder()
+	if ServeWithoutPreview(rw, req, rp, &chrome.MainResponse{Status: 200, MIMEType: "text/html"}) {
+		t.Errorf("ServeWithoutPreview() of an HTML page = true, want false")
+	}
+}
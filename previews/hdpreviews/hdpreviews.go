@@ -24,6 +24,7 @@ package hdpreviews
 
 import (
 	"compress/gzip"
+	"context"
 	"fmt"
 	htmlesc "html"
 	"io"
@@ -52,9 +53,12 @@ func New(chromeInstanceManager *chrome.InstanceManager) (*Handler, error) {
 	}, nil
 }
 
-// Close implements cleanup upon closing the handler.
+// Close implements cleanup upon closing the handler: it closes the Chrome instance manager,
+// waiting at most chrome.DefaultDrainTimeout for the instances in use.
 func (h *Handler) Close() error {
-	return nil
+	ctx, cancel := context.WithTimeout(context.Background(), chrome.DefaultDrainTimeout)
+	defer cancel()
+	return h.rendererManager.Close(ctx)
 }
 
 // Implements the handle function for serving a HTTP request.
@@ -66,21 +70,20 @@ func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	fmt.Printf("[HDP] Handling request for %s\n", req.URL.String())
 	queries := req.URL.Query()
 
-	if _, ok := queries["req_for_preview"]; ok {
-		// Send a query in parallel to make sure that we have the correct status code.
-		statusCodeChan := make(chan int)
-		defer close(statusCodeChan)
-		go func() {
-			// TODO: should we pass along all the request headers and non-Content-Encoding response headers?
-			response, err := http.Get(req.URL.String())
-			if err != nil {
-				statusCodeChan <- http.StatusBadGateway
-			}
-			statusCodeChan <- response.StatusCode
-		}()
-
-		instanceID := h.rendererManager.GetNewInstance(req.URL.String())
-		defer h.rendererManager.RemoveInstance(instanceID)
+	if _, ok := queries[handlerutils.PreviewQuery]; ok {
+		stability, err := handlerutils.RequestStability(req)
+		if err != nil {
+			fmt.Printf("invalid %v: %v\n", handlerutils.StabilityHeader, err)
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		instanceID, err := h.rendererManager.AcquireInstance(req.URL.String())
+		if err != nil {
+			fmt.Printf("failed to get chrome instance: %v\n", err)
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		defer h.rendererManager.ReleaseInstance(instanceID)
 
 		chromeInstance, err := h.rendererManager.GetInstance(instanceID)
 		if err != nil {
@@ -89,38 +92,49 @@ func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 			return
 		}
 
+		// Stop rendering the page if the client goes away.
+		chromeInstance.Bind(req.Context())
 		err = chromeInstance.WaitUntilChromeReady()
-		if err != nil || !chromeInstance.ResetTimeout() { // The timer already expired.
-			fmt.Printf("failed after waiting chrome to be ready: %v\n", err)
-			rw.WriteHeader(http.StatusBadGateway)
+		if err != nil || !chromeInstance.Touch() { // The instance already terminated.
+			fmt.Printf("failed after waiting chrome to be ready: %v, %v\n", err, chromeInstance.Err())
+			rw.WriteHeader(handlerutils.TerminationStatus(chromeInstance.Err()))
 			return
 		}
-		defer chromeInstance.DisconnectAndTerminate()
 
 		// (3) navigate to the page and the get the response.
-		chromeInstance.NavigateToPage(req.URL.String())
-		loaded := make(chan struct{})
-		go func() {
-			pageStabilized := false
-			for {
-				event, err := chromeInstance.NextEvent()
-				if err == io.EOF {
-					// no more events to process.
-					break
-				}
-				if pageStabilized {
-					// Throw all events away because the page has loaded.
-					continue
-				}
-				switch {
-				case event.Method == "Emulation.virtualTimeBudgetExpired":
-					// Page stablized.
-					pageStabilized = true
-					close(loaded)
-				}
+		// The events of the page are only read through subscriptions.
+		chromeInstance.DiscardEvents()
+		if stability != nil {
+			chromeInstance.SetStability(stability)
+		}
+		chromeInstance.SetClientHeaders(req.Header)
+		defer handlerutils.LogInterceptions(req.URL.String(), chromeInstance)
+		navigation, err := chromeInstance.NavigateToPage(req.URL.String())
+		if err != nil {
+			fmt.Printf("failed to navigate to %v: %v\n", req.URL, err)
+			rw.WriteHeader(handlerutils.TerminationStatus(err))
+			return
+		}
+		// The status and headers of the preview are the ones of the page.
+		response, err := navigation.Response(req.Context())
+		if err != nil {
+			fmt.Printf("failed to fetch %v: %v\n", req.URL, err)
+			rw.WriteHeader(handlerutils.TerminationStatus(err))
+			return
+		}
+		if handlerutils.ServeWithoutPreview(rw, req, h.rp, response) {
+			return
+		}
+		if err := chromeInstance.WaitUntilStable(); err != nil { // Wait for the page to be loaded
+			if err == chrome.ErrBudgetExceeded {
+				fmt.Printf("serving %v without preview: %v\n", req.URL, chrome.ErrBudgetExceeded)
+				handlerutils.ServeFallback(rw, req, h.rp, "budget-exceeded")
+				return
 			}
-		}()
-		<-loaded // Wait for the page to be loaded
+			fmt.Printf("connection to chrome ended before the page stabilized: %v\n", err)
+			rw.WriteHeader(handlerutils.TerminationStatus(err))
+			return
+		}
 		dom, err := chromeInstance.GetDOM()
 		if err != nil {
 			rw.WriteHeader(http.StatusBadGateway)
@@ -146,8 +160,8 @@ func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		// Content length will be different because we are striping <script> tags
 		rw.Header()["Content-Length"] = nil
 
-		statusCode := <-statusCodeChan
-		rw.WriteHeader(statusCode)
+		handlerutils.CopyOriginHeaders(rw.Header(), response)
+		rw.WriteHeader(response.Status)
 
 		_, err = io.WriteString(writer, resp)
 		if err != nil {
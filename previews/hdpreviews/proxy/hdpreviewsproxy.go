@@ -26,28 +26,34 @@ import (
 	"log"
 	"net/http"
 
-	"streaming_hdp/chrome"
+	"streaming_hdp/previews/handlerutils"
 	"streaming_hdp/previews/hdpreviews"
-)
-
-var (
-	port          = flag.Int("port", 8080, "The port the proxy will listen to.")
-	certFile      = flag.String("cert_file", "mycert.pem", "The SSL certificate file.")
-	keyFile       = flag.String("key_file", "mykey.pem", "The SSL key file.")
-	useFullChrome = flag.Bool("use_full_chrome", false, "Runs Chrome with the graphical interface.")
+	"streaming_hdp/previews/proxyflags"
 )
 
 func main() {
 	flag.Parse()
 
-	chromeInstanceManager := chrome.NewInstanceManager(*useFullChrome)
+	chromeInstanceManager, err := proxyflags.NewInstanceManager()
+	if err != nil {
+		log.Fatal(err)
+	}
 	hdpHandler, err := hdpreviews.New(chromeInstanceManager)
 	if err != nil {
 		log.Fatal("Failed to create HD Previews handler: %v\n", err)
 	}
+	if *proxyflags.DebugAddr != "" {
+		debugServer, err := handlerutils.ServeDebug(*proxyflags.DebugAddr, chromeInstanceManager)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer debugServer.Close()
+	}
 	server := &http.Server{
-		Addr:    fmt.Sprintf(":%d", *port),
+		Addr:    fmt.Sprintf(":%d", *proxyflags.Port),
 		Handler: hdpHandler,
 	}
-	log.Fatal(server.ListenAndServeTLS(*certFile, *keyFile))
+	if err := handlerutils.ServeTLS(server, *proxyflags.CertFile, *proxyflags.KeyFile, chromeInstanceManager, *proxyflags.ShutdownTimeout); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -23,12 +23,14 @@ import (
 	"testing"
 
 	"streaming_hdp/chrome"
+	"streaming_hdp/devtools/cdptest"
 	"streaming_hdp/previews/hdpreviews"
 	"streaming_hdp/previews/testutil"
 )
 
 // Tests that the response from HD Preview must not contain a script tag.
 func TestScriptTagRemoved(t *testing.T) {
+	cdptest.RequireChrome(t)
 	chromeInstanceManager := chrome.NewInstanceManager(true)
 	hdpreviewsHandler, err := hdpreviews.New(chromeInstanceManager)
 	if err != nil {
@@ -64,6 +66,7 @@ func TestScriptTagRemoved(t *testing.T) {
 
 // Tests that the response status code from HD Preview is correct.
 func TestResponseStatusCode(t *testing.T) {
+	cdptest.RequireChrome(t)
 	tests := []struct {
 		label           string
 		expectedRetCode int
@@ -0,0 +1,247 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package proxyflags defines the command line flags shared by the proxies, which configure the Chrome
+// instances rendering the pages, and builds the chrome.InstanceManager they describe.
+package proxyflags
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"streaming_hdp/chrome"
+	"streaming_hdp/devtools"
+	"streaming_hdp/previews/handlerutils"
+)
+
+// The flags serving the previews.
+var (
+	Port            = flag.Int("port", 8080, "The port the proxy will listen to.")
+	CertFile        = flag.String("cert_file", "mycert.pem", "The SSL certificate file.")
+	KeyFile         = flag.String("key_file", "mykey.pem", "The SSL key file.")
+	ShutdownTimeout = flag.Duration("shutdown_timeout", chrome.DefaultDrainTimeout, "On SIGINT or SIGTERM, how long to wait for the requests in flight before terminating Chrome instances.")
+	DebugAddr       = flag.String("debug_addr", "", "If set, the state of the Chrome instances, including the URLs being rendered, is served at "+handlerutils.DebugInstancesPath+" of this localhost address, e.g. localhost:8081.")
+)
+
+// The flags configuring the Chrome instances.
+var (
+	useFullChrome      = flag.Bool("use_full_chrome", false, "Runs Chrome with the graphical interface.")
+	devtoolsPipe       = flag.Bool("devtools_pipe", false, "Controls Chrome through a DevTools pipe instead of a local port, so that no other process can control it.")
+	keepaliveInterval  = flag.Duration("keepalive_interval", devtools.DefaultKeepaliveInterval, "How often idle Chrome instances are probed, to retire the ones that stopped responding.")
+	recordDir          = flag.String("record_dir", "", "If set, the DevTools messages of every Chrome instance are recorded to this directory.")
+	chromeBinary       = flag.String("chrome_binary", chrome.DefaultBinary, "The Chrome binary to start.")
+	chromeFlags        = flag.String("chrome_flags", "", "Extra command line flags of Chrome, separated by spaces.")
+	proxyServer        = flag.String("proxy_server", "", "If set, Chrome fetches pages through this proxy, e.g. http://localhost:3128.")
+	windowSize         = flag.String("window_size", "", "If set, the size of the window of Chrome, e.g. 1280x800.")
+	device             = flag.String("device", chrome.DefaultDevice, "The device to render pages for: "+strings.Join(chrome.DevicePresetNames(), ", ")+".")
+	userAgent          = flag.String("user_agent", "", "If set, overrides the User-Agent of the device.")
+	locale             = flag.String("locale", "", "If set, the locale to render pages in, e.g. fr-FR.")
+	timezone           = flag.String("timezone", "", "If set, the IANA timezone to render pages in, e.g. Europe/Paris.")
+	userDataDirRoot    = flag.String("user_data_dir_root", chrome.DefaultUserDataDirRoot, "The directory the user data directories of Chrome are created in.")
+	keepUserDataDir    = flag.Bool("keep_user_data_dir", false, "Keeps the user data directories of terminated Chrome instances, e.g. to inspect their crash dumps.")
+	poolMinIdle        = flag.Int("pool_min_idle", chrome.DefaultPoolOptions().MinIdle, "The number of Chrome instances kept started, waiting for requests.")
+	poolMaxIdle        = flag.Int("pool_max_idle", chrome.DefaultPoolOptions().MaxIdle, "The maximum number of Chrome instances waiting for requests.")
+	poolMaxTotal       = flag.Int("pool_max_total", chrome.DefaultPoolOptions().MaxTotal, "The maximum number of Chrome instances. Zero means no limit.")
+	poolSpawnInterval  = flag.Duration("pool_spawn_interval", chrome.DefaultPoolOptions().SpawnInterval, "The minimum time between starting two Chrome instances.")
+	poolIdleTimeout    = flag.Duration("pool_idle_timeout", chrome.DefaultPoolOptions().IdleTimeout, "Chrome instances beyond --pool_min_idle waiting for longer are terminated. Zero means never.")
+	poolAcquireTimeout = flag.Duration("pool_acquire_timeout", chrome.DefaultPoolOptions().AcquireTimeout, "How long a request waits for a Chrome instance before failing with 503. Zero fails right away.")
+	tabsPerProcess     = flag.Int("tabs_per_process", 1, "The number of requests served concurrently by one Chrome process, each in its own browser context. Requires --devtools_pipe.")
+	recycleNavigations = flag.Int("recycle_max_navigations", 0, "If above 1, Chrome instances are reset and reused for up to this many pages instead of terminated after each. Requires --devtools_pipe.")
+	recycleMaxMemory   = flag.Int64("recycle_max_memory_mb", 0, "If set, Chrome instances using more memory, in MB, are terminated instead of reused.")
+	cgroupParent       = flag.String("cgroup_parent", "", "If set, each Chrome instance runs in its own cgroup v2 created in this delegated directory, e.g. /sys/fs/cgroup/hdp.slice.")
+	cgroupMemoryMax    = flag.Int64("cgroup_memory_max_mb", 0, "If set with --cgroup_parent, the memory each Chrome instance may use, in MB.")
+	cgroupCPUMax       = flag.Float64("cgroup_cpu_max", 0, "If set with --cgroup_parent, the CPUs each Chrome instance may use, e.g. 0.5.")
+	cgroupPidsMax      = flag.Int("cgroup_pids_max", 0, "If set with --cgroup_parent, the number of processes and threads each Chrome instance may have.")
+	maxOpenFiles       = flag.Uint64("max_open_files", 0, "If set, the RLIMIT_NOFILE of Chrome.")
+	pageCPUBudget      = flag.Duration("page_cpu_budget", 0, "If set, pages using more CPU time are aborted, and served without preview.")
+	pageMemoryBudget   = flag.Int64("page_memory_budget_mb", 0, "If set, pages with a larger JavaScript heap, in MB, are aborted, and served without preview.")
+	requestTimeout     = flag.Duration("request_timeout", chrome.DefaultLifecycleOptions().RequestTimeout, "How long a page may take to be rendered. Zero means no limit.")
+	rendererIdle       = flag.Duration("renderer_idle_timeout", chrome.DefaultLifecycleOptions().IdleTimeout, "Chrome instances in use without activity for longer are terminated. Zero means never.")
+	instanceLifetime   = flag.Duration("instance_max_lifetime", chrome.DefaultLifecycleOptions().MaxLifetime, "Chrome instances used for longer, across pages when recycled, are terminated. Zero means no limit.")
+	remoteChrome       = flag.String("remote_chrome", "", "If set, pages are rendered in tabs of the Chrome instances at these comma-separated DevTools host:port or browser websocket URLs, instead of starting Chrome.")
+	remoteHealth       = flag.Duration("remote_health_interval", chrome.DefaultHealthCheckInterval, "How often the health of the Chrome instances of --remote_chrome is checked.")
+	stability          = flag.String("stability", chrome.DefaultStability().String(), "When pages are deemed stable, e.g. network-idle=500ms,dom-quiet=1s|max=10s. Requests override it with the "+handlerutils.StabilityHeader+" header.")
+	interceptRules     = flag.String("intercept_rules", "", "If set, a JSON file of rules blocking, rewriting or mocking the requests of pages, e.g. [{\"url\": \"*.mp4\", \"action\": \"block\"}].")
+	filterLists        = flag.String("filter_lists", "", "Comma-separated files of filters in the EasyList format, e.g. of ads and trackers. The requests of pages they match are blocked.")
+	blockTypes         = flag.String("block_types", "", "Comma-separated resource types whose requests are blocked, e.g. Image,Media,Font.")
+	blockThirdParty    = flag.Bool("block_third_party", false, "Blocks the requests of pages to other sites, besides the ones allowed by --intercept_rules.")
+	forwardHeaders     = flag.String("forward_headers", strings.Join(chrome.DefaultForwardPolicy().Allow, ","), "Comma-separated headers of the requests of clients forwarded to Chrome, where * ends a prefix, e.g. Cookie,Sec-CH-*. Add Authorization to render pages behind HTTP authentication.")
+	forwardDeny        = flag.String("forward_deny", "", "Comma-separated headers never forwarded to Chrome, even if matched by --forward_headers.")
+)
+
+// NewInstanceManager returns the manager of the Chrome instances configured by the flags.
+func NewInstanceManager() (*chrome.InstanceManager, error) {
+	launchOptions, err := LaunchOptions()
+	if err != nil {
+		return nil, fmt.Errorf("invalid Chrome options: %v", err)
+	}
+	backend, err := Backend(launchOptions)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Chrome backend: %v", err)
+	}
+	chromeInstanceManager := chrome.NewInstanceManagerWithBackend(backend)
+	chromeInstanceManager.SetPool(PoolOptions())
+	chromeInstanceManager.SetRecycle(RecycleOptions())
+	chromeInstanceManager.SetLifecycle(LifecycleOptions())
+	chromeInstanceManager.SetKeepalive(devtools.KeepaliveOptions{Interval: *keepaliveInterval})
+	chromeInstanceManager.SetRecordDir(*recordDir)
+	return chromeInstanceManager, nil
+}
+
+// Transport returns how the proxy exchanges DevTools messages with the Chrome instances it starts, from the flags.
+func Transport() chrome.DebuggingTransport {
+	if *devtoolsPipe {
+		return chrome.PipeTransport
+	}
+	return chrome.PortTransport
+}
+
+// PoolOptions returns the options of the pool of Chrome instances, from the flags.
+func PoolOptions() chrome.PoolOptions {
+	return chrome.PoolOptions{
+		MinIdle:        *poolMinIdle,
+		MaxIdle:        *poolMaxIdle,
+		MaxTotal:       *poolMaxTotal,
+		SpawnInterval:  *poolSpawnInterval,
+		IdleTimeout:    *poolIdleTimeout,
+		AcquireTimeout: *poolAcquireTimeout,
+		TabsPerProcess: *tabsPerProcess,
+	}
+}
+
+// RecycleOptions returns when Chrome instances are reused for other pages, from the flags.
+func RecycleOptions() chrome.RecycleOptions {
+	return chrome.RecycleOptions{
+		MaxNavigations: *recycleNavigations,
+		MaxMemory:      *recycleMaxMemory << 20,
+	}
+}
+
+// LifecycleOptions returns how long Chrome instances may render pages and live, from the flags.
+func LifecycleOptions() chrome.LifecycleOptions {
+	return chrome.LifecycleOptions{
+		RequestTimeout: *requestTimeout,
+		IdleTimeout:    *rendererIdle,
+		MaxLifetime:    *instanceLifetime,
+	}
+}
+
+// Backend returns the backend providing the Chrome instances started with opts, from the flags.
+func Backend(opts chrome.LaunchOptions) (chrome.Backend, error) {
+	if *remoteChrome == "" {
+		// Chrome instances controlled through a port have neither tabs nor resets.
+		if !*devtoolsPipe && *tabsPerProcess > 1 {
+			return nil, errors.New("--tabs_per_process requires --devtools_pipe")
+		}
+		if !*devtoolsPipe && *recycleNavigations > 1 {
+			return nil, errors.New("--recycle_max_navigations requires --devtools_pipe")
+		}
+		return chrome.NewLocalBackend(opts, Transport()), nil
+	}
+	if *tabsPerProcess > 1 {
+		return nil, errors.New("--tabs_per_process does not apply to --remote_chrome, whose tabs each have their own browser context")
+	}
+	return chrome.NewRemoteBackend(strings.Split(*remoteChrome, ","), opts, *remoteHealth)
+}
+
+// LaunchOptions returns the options to start Chrome instances with, from the flags.
+func LaunchOptions() (chrome.LaunchOptions, error) {
+	profile, err := chrome.DevicePreset(*device)
+	if err != nil {
+		return chrome.LaunchOptions{}, err
+	}
+	opts := chrome.LaunchOptions{
+		Binary:          *chromeBinary,
+		UseFullChrome:   *useFullChrome,
+		Flags:           strings.Fields(*chromeFlags),
+		ProxyServer:     *proxyServer,
+		Device:          &profile,
+		UserAgent:       *userAgent,
+		Locale:          *locale,
+		Timezone:        *timezone,
+		UserDataDirRoot: *userDataDirRoot,
+		Limits: chrome.ResourceLimits{
+			CgroupParent: *cgroupParent,
+			MemoryMax:    *cgroupMemoryMax << 20,
+			CPUMax:       *cgroupCPUMax,
+			PidsMax:      *cgroupPidsMax,
+			MaxOpenFiles: *maxOpenFiles,
+		},
+		Budget: chrome.Budget{
+			CPU:    *pageCPUBudget,
+			Memory: *pageMemoryBudget << 20,
+		},
+		Forward: chrome.ForwardPolicy{
+			Allow: strings.FieldsFunc(*forwardHeaders, isComma),
+			Deny:  strings.FieldsFunc(*forwardDeny, isComma),
+		},
+	}
+	if opts.Limits.CgroupParent == "" && (opts.Limits.MemoryMax > 0 || opts.Limits.CPUMax > 0 || opts.Limits.PidsMax > 0) {
+		return chrome.LaunchOptions{}, errors.New("the cgroup limits require --cgroup_parent")
+	}
+	if opts.Stability, err = chrome.ParseStability(*stability); err != nil {
+		return chrome.LaunchOptions{}, err
+	}
+	if opts.Interceptor, err = newInterceptor(); err != nil {
+		return chrome.LaunchOptions{}, err
+	}
+	if *windowSize != "" {
+		if opts.WindowWidth, opts.WindowHeight, err = chrome.ParseWindowSize(*windowSize); err != nil {
+			return chrome.LaunchOptions{}, err
+		}
+	}
+	if *keepUserDataDir {
+		opts.UserDataDir = chrome.KeepUserDataDir
+	}
+	return opts, nil
+}
+
+// newInterceptor returns the interceptor of the requests of pages, from the flags, or nil if they intercept none.
+func newInterceptor() (*chrome.Interceptor, error) {
+	var rules []chrome.InterceptRule
+	if *interceptRules != "" {
+		var err error
+		if rules, err = chrome.LoadInterceptRules(*interceptRules); err != nil {
+			return nil, err
+		}
+	}
+	if *blockTypes != "" {
+		rules = append(rules, chrome.InterceptRule{ResourceTypes: strings.Split(*blockTypes, ","), Action: chrome.InterceptBlock})
+	}
+	if *blockThirdParty {
+		rules = append(rules, chrome.InterceptRule{ThirdParty: true, Action: chrome.InterceptBlock})
+	}
+	var lists []*chrome.FilterList
+	if *filterLists != "" {
+		for _, path := range strings.Split(*filterLists, ",") {
+			list, err := chrome.LoadFilterList(path)
+			if err != nil {
+				return nil, err
+			}
+			fmt.Printf("loaded %v filters from %v\n", list.Filters, path)
+			lists = append(lists, list)
+		}
+	}
+	if len(rules) == 0 && len(lists) == 0 {
+		return nil, nil
+	}
+	return chrome.NewInterceptor(rules, lists...)
+}
+
+// isComma returns whether r separates the elements of the lists of the flags.
+func isComma(r rune) bool {
+	return r == ','
+}
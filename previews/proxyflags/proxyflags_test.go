@@ -0,0 +1,102 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyflags
+
+import (
+	"flag"
+	"testing"
+
+	"streaming_hdp/chrome"
+)
+
+// setFlags sets the command line flags to values, and returns a function restoring them.
+func setFlags(t *testing.T, values map[string]string) func() {
+	previous := make(map[string]string)
+	for name, value := range values {
+		previous[name] = flag.Lookup(name).Value.String()
+		if err := flag.Set(name, value); err != nil {
+			t.Fatalf("flag.Set(%v, %v): %v", name, value, err)
+		}
+	}
+	return func() {
+		for name, value := range previous {
+			flag.Set(name, value)
+		}
+	}
+}
+
+func TestLaunchOptions(t *testing.T) {
+	defer setFlags(t, map[string]string{
+		"window_size":           "1280x800",
+		"block_types":           "Image,Media",
+		"forward_headers":       "Cookie,Sec-CH-*",
+		"page_memory_budget_mb": "64",
+	})()
+	opts, err := LaunchOptions()
+	if err != nil {
+		t.Fatalf("LaunchOptions: %v", err)
+	}
+	if opts.WindowWidth != 1280 || opts.WindowHeight != 800 {
+		t.Errorf("window size got: %vx%v, want: 1280x800", opts.WindowWidth, opts.WindowHeight)
+	}
+	if opts.Interceptor == nil {
+		t.Errorf("--block_types got no interceptor")
+	}
+	if len(opts.Forward.Allow) != 2 || opts.Forward.Allow[1] != "Sec-CH-*" {
+		t.Errorf("forwarded headers got: %v, want: [Cookie Sec-CH-*]", opts.Forward.Allow)
+	}
+	if opts.Budget.Memory != 64<<20 {
+		t.Errorf("memory budget got: %v, want: %v", opts.Budget.Memory, 64<<20)
+	}
+}
+
+func TestInvalidFlags(t *testing.T) {
+	testCases := []struct {
+		name  string
+		flags map[string]string
+	}{
+		{"cgroup limits without parent", map[string]string{"cgroup_memory_max_mb": "512"}},
+		{"unknown device", map[string]string{"device": "toaster"}},
+		{"invalid stability", map[string]string{"stability": "sometime"}},
+		{"tabs of remote chrome", map[string]string{"remote_chrome": "localhost:9222", "tabs_per_process": "4"}},
+		{"tabs without pipe", map[string]string{"tabs_per_process": "4"}},
+		{"recycling without pipe", map[string]string{"recycle_max_navigations": "10"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			defer setFlags(t, tc.flags)()
+			if _, err := NewInstanceManager(); err == nil {
+				t.Errorf("NewInstanceManager() succeeded")
+			}
+		})
+	}
+}
+
+func TestTransport(t *testing.T) {
+	if got := Transport(); got != chrome.PortTransport {
+		t.Errorf("Transport() got: %v, want: %v", got, chrome.PortTransport)
+	}
+	defer setFlags(t, map[string]string{"devtools_pipe": "true"})()
+	if got := Transport(); got != chrome.PipeTransport {
+		t.Errorf("Transport() with --devtools_pipe got: %v, want: %v", got, chrome.PipeTransport)
+	}
+}
+
+func TestBackendWithPipe(t *testing.T) {
+	defer setFlags(t, map[string]string{"devtools_pipe": "true", "tabs_per_process": "4", "recycle_max_navigations": "10"})()
+	if _, err := Backend(chrome.LaunchOptions{}); err != nil {
+		t.Errorf("Backend() with --devtools_pipe: %v", err)
+	}
+}
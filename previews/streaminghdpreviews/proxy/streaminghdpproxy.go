@@ -26,26 +26,26 @@ import (
 	"log"
 	"net/http"
 
-	"streaming_hdp/chrome"
+	"streaming_hdp/previews/handlerutils"
+	"streaming_hdp/previews/proxyflags"
 	"streaming_hdp/previews/streaminghdpreviews"
 	"streaming_hdp/previews/streaminghdpreviews/stream"
 )
 
 var (
-	proxyHost     = flag.String("proxy_host", "localhost", "The host that the proxy is running on.")
-	port          = flag.Int("port", 8080, "The port the proxy will listen to.")
-	certFile      = flag.String("cert_file", "mycert.pem", "The SSL certificate file.")
-	keyFile       = flag.String("key_file", "mykey.pem", "The SSL key file.")
-	verbose       = flag.Bool("verbose", false, "Enable verbose output.")
-	useFullChrome = flag.Bool("use_full_chrome", false, "Runs Chrome with the graphical interface.")
-	staticDir     = flag.String("static_dir", "static", "The directory where the static HTML and JavaScript files can be found.")
+	proxyHost = flag.String("proxy_host", "localhost", "The host that the proxy is running on.")
+	verbose   = flag.Bool("verbose", false, "Enable verbose output.")
+	staticDir = flag.String("static_dir", "static", "The directory where the static HTML and JavaScript files can be found.")
 )
 
 func main() {
 	flag.Parse()
 
-	chromeInstanceManager := chrome.NewInstanceManager(*useFullChrome)
-	hdpHandler, err := streaminghdpreviews.New(*proxyHost, *port, chromeInstanceManager, *staticDir)
+	chromeInstanceManager, err := proxyflags.NewInstanceManager()
+	if err != nil {
+		log.Fatal(err)
+	}
+	hdpHandler, err := streaminghdpreviews.New(*proxyHost, *proxyflags.Port, chromeInstanceManager, *staticDir)
 	if err != nil {
 		log.Fatalf("Failed to create HD Previews handler: %v\n", err)
 	}
@@ -57,8 +57,17 @@ func main() {
 	}
 	http.Handle("/stream", streamHandler)
 
+	if *proxyflags.DebugAddr != "" {
+		debugServer, err := handlerutils.ServeDebug(*proxyflags.DebugAddr, chromeInstanceManager)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer debugServer.Close()
+	}
 	server := &http.Server{
-		Addr: fmt.Sprintf(":%d", *port),
+		Addr: fmt.Sprintf(":%d", *proxyflags.Port),
+	}
+	if err := handlerutils.ServeTLS(server, *proxyflags.CertFile, *proxyflags.KeyFile, chromeInstanceManager, *proxyflags.ShutdownTimeout); err != nil {
+		log.Fatal(err)
 	}
-	log.Fatal(server.ListenAndServeTLS(*certFile, *keyFile))
 }
@@ -18,6 +18,7 @@ package stream
 
 import (
 	"compress/gzip"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -28,8 +29,10 @@ import (
 
 	"streaming_hdp/chrome"
 	"streaming_hdp/devtools"
+	"streaming_hdp/devtools/cdp"
 	"streaming_hdp/dom"
 	"streaming_hdp/dom/domjson"
+	"streaming_hdp/previews/handlerutils"
 )
 
 const (
@@ -67,9 +70,12 @@ func New(chromeInstanceManager *chrome.InstanceManager, verbose bool) (*Handler,
 	return &newHandler, nil
 }
 
-// Close implements cleanup upon closing the handler.
+// Close implements cleanup upon closing the handler: it closes the Chrome instance manager,
+// waiting at most chrome.DefaultDrainTimeout for the instances in use.
 func (h *Handler) Close() error {
-	return nil
+	ctx, cancel := context.WithTimeout(context.Background(), chrome.DefaultDrainTimeout)
+	defer cancel()
+	return h.rendererManager.Close(ctx)
 }
 
 // Implements the handle function for serving a HTTP request.
@@ -87,7 +93,7 @@ func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		rw.WriteHeader(http.StatusBadRequest)
 		return
 	}
-	defer h.rendererManager.RemoveInstance(instanceID)
+	defer h.rendererManager.ReleaseInstance(instanceID)
 	fmt.Printf("Serving stream request with instance id: %v\n", instanceID)
 
 	chromeInstance, err := h.rendererManager.GetInstance(instanceID)
@@ -96,16 +102,28 @@ func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		rw.WriteHeader(http.StatusBadGateway)
 		return
 	}
+	if page, err := h.rendererManager.GetURL(instanceID); err == nil {
+		defer handlerutils.LogInterceptions(page, chromeInstance)
+	}
+
+	if chromeInstance.Err() == chrome.ErrBudgetExceeded {
+		// Let the client fall back to the original page.
+		fmt.Printf("instance %v aborted the page: %v\n", instanceID, chrome.ErrBudgetExceeded)
+		rw.Header().Set(handlerutils.FallbackHeader, "budget-exceeded")
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
 
+	// Stop rendering the page if the client goes away.
+	chromeInstance.Bind(req.Context())
 	fmt.Printf("Waiting for Chrome to be ready: %v\n", instanceID)
 	err = chromeInstance.WaitUntilChromeReady()
-	if err != nil || !chromeInstance.ResetTimeout() { // The timer already expired.
-		fmt.Printf("failed after waiting chrome to be ready: %v\n", err)
-		rw.WriteHeader(http.StatusBadGateway)
+	if err != nil || !chromeInstance.Touch() { // The instance already terminated.
+		fmt.Printf("failed after waiting chrome to be ready: %v, %v\n", err, chromeInstance.Err())
+		rw.WriteHeader(handlerutils.TerminationStatus(chromeInstance.Err()))
 		return
 	}
 	fmt.Printf("Got Chrome: %v\n", instanceID)
-	defer chromeInstance.DisconnectAndTerminate()
 
 	rw.Header().Set("Content-Encoding", "gzip")
 	writer, err := gzip.NewWriterLevel(rw, gzip.BestCompression)
@@ -118,16 +136,46 @@ func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	rw.Header().Set("Content-Type", "application/octet-stream")
 	rw.Header().Set("Access-Control-Allow-Origin", "*")
 	rw.WriteHeader(http.StatusOK)
+	h.streamUpdates(chromeInstance, writer)
+}
+
+// streamUpdates sends the DOM updates of the page loaded in Chrome to the client, until the page has stabilized.
+func (h *Handler) streamUpdates(chromeInstance *chrome.Instance, writer *gzip.Writer) {
 	domModel := dom.NewDOMModel()
 
-	// TODO(vaspol): We perform blocking actions in the event loop (wsConnection.WriteMessage and
-	// chromeInstance.GetDOMInstance). This is problematic because DevTools events will
-	// get buffered while we're not processing them. It is possible that more events will
-	// be buffered than can fit in the buffered channel, thus creating a deadlock.
-	// For now we ignore this problem.
+	// Stop waiting for events once the page has stabilized.
+	stable := chromeInstance.Stable()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stable:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// We perform blocking actions in the event loop (writing to the client and
+	// chromeInstance.GetDOMInstance). DevTools events are buffered without bound
+	// by the devtools.Connection while we are not processing them.
 	for {
-		event, err := chromeInstance.NextEvent()
-		if err == io.EOF {
+		select {
+		case <-stable:
+			// Page has stablized.
+			return
+		default:
+		}
+		event, err := chromeInstance.NextEventContext(ctx)
+		if err != nil {
+			if err == context.Canceled {
+				// Page has stablized.
+				return
+			}
+			if reason := chromeInstance.Err(); reason != nil && reason != chrome.ErrTerminated {
+				fmt.Printf("chrome aborted the page: %v\n", reason)
+			} else if err != io.EOF {
+				fmt.Printf("connection to chrome ended: %v\n", err)
+			}
 			// no more events to process.
 			return
 		}
@@ -152,7 +200,12 @@ func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 				return
 			}
 		case DomChildNodeCountUpdated:
-			chromeInstance.RequestChildNodes(event.Params["nodeId"].(float64))
+			var countUpdated cdp.DOMChildNodeCountUpdatedEvent
+			if err := cdp.DecodeEvent(event, &countUpdated); err != nil {
+				fmt.Printf("error decoding childNodeCountUpdated: %v\n", err)
+				continue
+			}
+			chromeInstance.RequestChildNodes(float64(countUpdated.NodeID))
 
 		case DomSetChildNodes:
 			domUpdates, err := domModel.ProcessSetChildNodes(dom.Node(event.Params))
@@ -167,8 +220,12 @@ func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 				continue
 			}
 		case DomChildNodeInserted:
-			node := event.Params["node"].(map[string]interface{})
-			chromeInstance.RequestChildNodes(node["nodeId"].(float64))
+			var inserted cdp.DOMChildNodeInsertedEvent
+			if err := cdp.DecodeEvent(event, &inserted); err != nil || inserted.Node == nil {
+				fmt.Printf("error decoding childNodeInserted: %v\n", err)
+				continue
+			}
+			chromeInstance.RequestChildNodes(float64(inserted.Node.NodeID))
 			fallthrough
 		case DomAttributeModified:
 			fallthrough
@@ -177,9 +234,6 @@ func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 			if err != nil {
 				continue
 			}
-		case EmulationVirtualTimeBudgetExpired:
-			// Page has stablized.
-			return
 		}
 	}
 }
@@ -17,19 +17,27 @@ package stream
 import (
 	"bufio"
 	"bytes"
+	"compress/gzip"
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
 	"streaming_hdp/chrome"
+	"streaming_hdp/devtools"
+	"streaming_hdp/devtools/cdptest"
 	"streaming_hdp/dom/domjson"
 )
 
 func TestWSStreamingUpdates(t *testing.T) {
+	cdptest.RequireChrome(t)
 	tests := []struct {
 		dom                string
 		expectedNumUpdates int
@@ -94,7 +102,7 @@ func TestWSStreamingUpdates(t *testing.T) {
 
 			t.Logf("Waiting for Chrome to be ready: %v", chromeID)
 			err = chromeInstance.WaitUntilChromeReady()
-			if err != nil || !chromeInstance.ResetTimeout() { // The timer already expired.
+			if err != nil || !chromeInstance.Touch() { // The instance already terminated.
 				t.Fatalf("failed after waiting chrome to be ready: %v", err)
 			}
 			t.Logf("Got Chrome: %v", chromeID)
@@ -144,3 +152,76 @@ func TestWSStreamingUpdates(t *testing.T) {
 		})
 	}
 }
+
+// Replays a recorded session of Chrome, as written with the --record_dir flag of the proxy.
+func TestReplayedStreamingUpdates(t *testing.T) {
+	f, err := os.Open("testdata/inserted_via_js.jsonl")
+	if err != nil {
+		t.Fatalf("failed to open the recording: %v", err)
+	}
+	defer f.Close()
+	recording, err := devtools.ReadRecording(f)
+	if err != nil {
+		t.Fatalf("failed to read the recording: %v", err)
+	}
+	transport, err := devtools.NewReplayTransport(recording)
+	if err != nil {
+		t.Fatalf("failed to replay the recording: %v", err)
+	}
+	chromeInstance := chrome.Attach(devtools.NewConnectionWithTransport(transport))
+	chromeInstance.Begin(context.Background(), chrome.DefaultLifecycleOptions())
+	defer chromeInstance.DisconnectAndTerminate()
+
+	streamHandler, err := New(nil, false)
+	if err != nil {
+		t.Fatalf("failed to create stream handler  %v", err)
+	}
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	streamHandler.streamUpdates(chromeInstance, writer)
+	writer.Close()
+
+	reader, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatalf("failed to decompress the stream: %v", err)
+	}
+	type update struct {
+		action domjson.Action
+		nodeID string
+	}
+	var got []update
+	scanner := bufio.NewScanner(reader)
+	scanner.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		if i := bytes.Index(data, []byte(delim)); i >= 0 {
+			return i + len(delim), data[:i], nil
+		}
+		if atEOF && len(data) > 0 {
+			return 0, nil, errors.New("unterminated message")
+		}
+		return 0, nil, nil
+	})
+	for scanner.Scan() {
+		updates := &domjson.DOMUpdates{}
+		if err := json.Unmarshal(scanner.Bytes(), updates); err != nil {
+			t.Fatalf("json message in an incorrect format: %v, err: %v", scanner.Text(), err)
+		}
+		for _, u := range updates.Updates {
+			got = append(got, update{u.Action, u.Node.NodeID})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("failed to read the stream: %v", err)
+	}
+
+	// 6 nodes from the initial DOM, then the inserted div, its attribute and the removed div.
+	want := []update{
+		{domjson.Insert, "1"}, {domjson.Insert, "2"}, {domjson.Insert, "3"}, {domjson.Insert, "4"}, {domjson.Insert, "5"},
+		{domjson.Insert, "6"}, {domjson.Insert, "7"}, {domjson.Modify, "7"}, {domjson.Remove, "5"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got updates: %v, want: %v", got, want)
+	}
+	if mismatches := transport.Mismatches(); len(mismatches) != 0 {
+		t.Errorf("the replayed session diverged from the recording: %v", mismatches)
+	}
+}
@@ -24,6 +24,7 @@ package streaminghdpreviews
 
 import (
 	"compress/gzip"
+	"context"
 	"fmt"
 	htmlesc "html"
 	"html/template"
@@ -83,9 +84,12 @@ func New(proxyHost string, port int, chromeInstanceManager *chrome.InstanceManag
 	return &newHandler, nil
 }
 
-// Close implements cleanup upon closing the handler.
+// Close implements cleanup upon closing the handler: it closes the Chrome instance manager,
+// waiting at most chrome.DefaultDrainTimeout for the instances in use.
 func (h *Handler) Close() error {
-	return nil
+	ctx, cancel := context.WithTimeout(context.Background(), chrome.DefaultDrainTimeout)
+	defer cancel()
+	return h.rendererManager.Close(ctx)
 }
 
 // Implements the handle function for serving a HTTP request.
@@ -129,17 +133,38 @@ func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	// document type. We will skip that by adding a custom
 	// query parameter that indicates that this is the main
 	// SHDP document.
-	if _, ok := queries["req_for_preview"]; ok {
-		// TODO(vaspol): 2 potential issues:
-		// (1) This means that we only do SHDP for only the main frame.
+	if _, ok := queries[handlerutils.PreviewQuery]; ok {
+		// TODO(vaspol): This means that we only do SHDP for only the main frame.
 		// May have to revisit how to handle other iframes.
-		// (2) Since we are not requesting for the actual resource, we
-		// will be missing the correct response headers that are supposed
-		// to be part of the main document's response.
 
 		// TODO(vaspol): This will also include the "req_for_preview" query
 		// param. Most servers will probably ignore this. Ideally, we want to remove this.
-		chromeID := h.rendererManager.GetNewInstance(req.URL.String())
+		stability, err := handlerutils.RequestStability(req)
+		if err != nil {
+			fmt.Printf("invalid %v: %v\n", handlerutils.StabilityHeader, err)
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		chromeID, err := h.rendererManager.AcquireInstance(req.URL.String())
+		if err != nil {
+			fmt.Printf("failed to get chrome instance: %v\n", err)
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		// (1) Start rendering the page, to answer with its status and headers.
+		response, err := h.navigate(chromeID, req, stability)
+		if err != nil {
+			fmt.Printf("failed to fetch %v: %v\n", req.URL, err)
+			// The client will not stream the page.
+			h.rendererManager.ReleaseInstance(chromeID)
+			rw.WriteHeader(handlerutils.TerminationStatus(err))
+			return
+		}
+		if handlerutils.ServeWithoutPreview(rw, req, h.rp, response) {
+			h.rendererManager.ReleaseInstance(chromeID)
+			return
+		}
 
 		// Generate the JS stub.
 		templateData := struct {
@@ -153,6 +178,7 @@ func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		}
 
 		// (2) Return with the templated response.
+		handlerutils.CopyOriginHeaders(rw.Header(), response)
 		rw.Header().Set("Content-Encoding", "gzip")
 		writer, err := gzip.NewWriterLevel(rw, gzip.BestCompression)
 		if err != nil {
@@ -164,28 +190,7 @@ func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		rw.Header().Del("Content-Length")
 		rw.Header().Set("Content-Type", "text/html")
 		rw.Header().Set("Access-Control-Allow-Origin", "*")
-		rw.WriteHeader(http.StatusOK)
-
-		// Start navigating to the page.
-		go func() {
-			chromeInstance, err := h.rendererManager.GetInstance(chromeID)
-			if err != nil {
-				fmt.Printf("failed to get chrome instance: %v\n", err)
-				return
-			}
-
-			fmt.Printf("Waiting for Chrome to be ready: %v\n", chromeID)
-			err = chromeInstance.WaitUntilChromeReady()
-			if err != nil || !chromeInstance.ResetTimeout() { // The timer already expired.
-				fmt.Printf("failed after waiting chrome to be ready: %v\n", err)
-				return
-			}
-			fmt.Printf("Got Chrome: %v\n", chromeID)
-
-			// Subscribe to events.
-			chromeInstance.EnableDomains("DOM")
-			chromeInstance.NavigateToPage(req.URL.String())
-		}()
+		rw.WriteHeader(response.Status)
 
 		err = h.htmlTemplate.Execute(writer, templateData)
 		if err != nil {
@@ -196,6 +201,36 @@ func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// navigate starts rendering the page requested by req in the Chrome instance with instanceID, and
+// returns the response to the request of the page.
+func (h *Handler) navigate(instanceID int, req *http.Request, stability chrome.StabilityCondition) (*chrome.MainResponse, error) {
+	chromeInstance, err := h.rendererManager.GetInstance(instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("Waiting for Chrome to be ready: %v\n", instanceID)
+	if err := chromeInstance.WaitUntilChromeReady(); err != nil {
+		return nil, err
+	}
+	if !chromeInstance.Touch() { // The instance already terminated.
+		return nil, chromeInstance.Err()
+	}
+	fmt.Printf("Got Chrome: %v\n", instanceID)
+
+	// Subscribe to events.
+	chromeInstance.EnableDomains("DOM")
+	if stability != nil {
+		chromeInstance.SetStability(stability)
+	}
+	chromeInstance.SetClientHeaders(req.Header)
+	navigation, err := chromeInstance.NavigateToPage(req.URL.String())
+	if err != nil {
+		return nil, err
+	}
+	return navigation.Response(req.Context())
+}
+
 // This blocks until Chrome instance with instanceID finishes loading the page.
 func (h *Handler) handleSlowScript(instanceID int) error {
 	chromeInstance, err := h.rendererManager.GetInstance(instanceID)
@@ -205,8 +240,11 @@ func (h *Handler) handleSlowScript(instanceID int) error {
 	}
 
 	err = chromeInstance.WaitUntilChromeReady()
-	if err != nil || !chromeInstance.ResetTimeout() {
-		fmt.Printf("failed after waiting chrome to be ready: %v\n", err)
+	if err != nil || !chromeInstance.Touch() {
+		fmt.Printf("failed after waiting chrome to be ready: %v, %v\n", err, chromeInstance.Err())
+		if err == nil {
+			err = chromeInstance.Err()
+		}
 		return err
 	}
 
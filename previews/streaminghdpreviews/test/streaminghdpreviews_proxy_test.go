@@ -25,6 +25,7 @@ import (
 	"testing"
 
 	"streaming_hdp/chrome"
+	"streaming_hdp/devtools/cdptest"
 	"streaming_hdp/previews/streaminghdpreviews"
 	"streaming_hdp/previews/testutil"
 )
@@ -107,6 +108,7 @@ func TestResourceGzipped(t *testing.T) {
 
 // Tests that the proxy returns the correct template response.
 func TestTemplateProxyResponse(t *testing.T) {
+	cdptest.RequireChrome(t)
 	tests := []struct {
 		label               string
 		expectedRetCode     int